@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"fmt"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,6 +42,40 @@ const (
 	ModelProviderBedrock           ModelProvider = "Bedrock"
 )
 
+// ModelProviders lists every ModelProvider value, in the order of the enum marker above.
+var ModelProviders = []ModelProvider{
+	ModelProviderAnthropic,
+	ModelProviderOpenAI,
+	ModelProviderAzureOpenAI,
+	ModelProviderOllama,
+	ModelProviderGemini,
+	ModelProviderGeminiVertexAI,
+	ModelProviderAnthropicVertexAI,
+	ModelProviderBedrock,
+}
+
+// NormalizeProviderType maps a user-supplied provider name to the ModelProvider enum.
+// Matching ignores case and "-", "_" and space separators, so "openai", "OPENAI"
+// and "azure_openai" are all accepted.
+func NormalizeProviderType(providerType string) (ModelProvider, error) {
+	key := normalizeProviderKey(providerType)
+	for _, provider := range ModelProviders {
+		if normalizeProviderKey(string(provider)) == key {
+			return provider, nil
+		}
+	}
+
+	valid := make([]string, 0, len(ModelProviders))
+	for _, provider := range ModelProviders {
+		valid = append(valid, string(provider))
+	}
+	return "", fmt.Errorf("unsupported provider type %q: must be one of %s", providerType, strings.Join(valid, ", "))
+}
+
+func normalizeProviderKey(providerType string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "", " ", "").Replace(strings.TrimSpace(providerType)))
+}
+
 type BaseVertexAIConfig struct {
 	// The project ID
 	// +required
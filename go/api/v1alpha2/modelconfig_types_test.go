@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeProviderType(t *testing.T) {
+	tests := []struct {
+		input string
+		want  ModelProvider
+	}{
+		{"OpenAI", ModelProviderOpenAI},
+		{"openai", ModelProviderOpenAI},
+		{"OPENAI", ModelProviderOpenAI},
+		{" OpenAI ", ModelProviderOpenAI},
+		{"anthropic", ModelProviderAnthropic},
+		{"azureopenai", ModelProviderAzureOpenAI},
+		{"azure_openai", ModelProviderAzureOpenAI},
+		{"Azure-OpenAI", ModelProviderAzureOpenAI},
+		{"ollama", ModelProviderOllama},
+		{"gemini", ModelProviderGemini},
+		{"gemini_vertex_ai", ModelProviderGeminiVertexAI},
+		{"anthropic-vertex-ai", ModelProviderAnthropicVertexAI},
+		{"BEDROCK", ModelProviderBedrock},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizeProviderType(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizeProviderTypeCanonicalValues(t *testing.T) {
+	for _, provider := range ModelProviders {
+		got, err := NormalizeProviderType(string(provider))
+		require.NoError(t, err)
+		assert.Equal(t, provider, got)
+	}
+}
+
+func TestNormalizeProviderTypeUnknown(t *testing.T) {
+	for _, input := range []string{"", "gpt", "open ai x", "UnsupportedProvider"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := NormalizeProviderType(input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "must be one of Anthropic, OpenAI, AzureOpenAI, Ollama, Gemini, GeminiVertexAI, AnthropicVertexAI, Bedrock")
+		})
+	}
+}
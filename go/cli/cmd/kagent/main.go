@@ -14,6 +14,7 @@ import (
 	"github.com/kagent-dev/kagent/go/cli/internal/profiles"
 	"github.com/kagent-dev/kagent/go/cli/internal/tui"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 func main() {
@@ -39,13 +40,19 @@ func main() {
 		Short: "kagent is a CLI and TUI for kagent",
 		Long:  "kagent is a CLI and TUI for kagent",
 		Run:   runInteractive,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// -o overrides output_format from the config file when set
+			cfg.OutputFormat = viper.GetString("output_format")
+			return cfg.Validate()
+		},
 	}
 
 	rootCmd.PersistentFlags().StringVar(&cfg.KAgentURL, "kagent-url", "http://localhost:8083", "KAgent URL")
 	rootCmd.PersistentFlags().StringVarP(&cfg.Namespace, "namespace", "n", "kagent", "Namespace")
-	rootCmd.PersistentFlags().StringVarP(&cfg.OutputFormat, "output-format", "o", "table", "Output format")
+	rootCmd.PersistentFlags().StringVarP(&cfg.OutputFormat, "output-format", "o", config.OutputFormatTable, "Output format (table|json|yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&cfg.Verbose, "verbose", "v", false, "Verbose output")
 	rootCmd.PersistentFlags().DurationVar(&cfg.Timeout, "timeout", 300*time.Second, "Timeout")
+	_ = viper.BindPFlag("output_format", rootCmd.PersistentFlags().Lookup("output-format"))
 	installCfg := &cli.InstallCfg{
 		Config: cfg,
 	}
@@ -6,19 +6,14 @@ import (
 	"slices"
 
 	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kagent-dev/kagent/go/cli/internal/config"
 	"github.com/kagent-dev/kagent/go/internal/utils"
 	"github.com/spf13/viper"
-)
-
-type OutputFormat string
-
-const (
-	OutputFormatJSON  OutputFormat = "json"
-	OutputFormatTable OutputFormat = "table"
+	"sigs.k8s.io/yaml"
 )
 
 func printOutput(data any, tableHeaders []string, tableRows [][]string) error {
-	format := OutputFormat(viper.GetString("output_format"))
+	format := viper.GetString("output_format")
 
 	tw := table.NewWriter()
 	headers := slices.Collect(utils.Map(slices.Values(tableHeaders), func(header string) any {
@@ -33,9 +28,11 @@ func printOutput(data any, tableHeaders []string, tableRows [][]string) error {
 	tw.AppendRows(rows)
 
 	switch format {
-	case OutputFormatJSON:
+	case config.OutputFormatJSON:
 		return printJSON(data)
-	case OutputFormatTable:
+	case config.OutputFormatYAML:
+		return printYAML(data)
+	case config.OutputFormatTable:
 		fmt.Println(tw.Render())
 		return nil
 	default:
@@ -43,6 +40,15 @@ func printOutput(data any, tableHeaders []string, tableRows [][]string) error {
 	}
 }
 
+func printYAML(data any) error {
+	output, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("error formatting YAML: %w", err)
+	}
+	fmt.Print(string(output))
+	return nil
+}
+
 func printJSON(data any) error {
 	output, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
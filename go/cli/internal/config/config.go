@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	kagentclient "github.com/kagent-dev/kagent/go/pkg/client"
@@ -12,16 +14,40 @@ import (
 	"github.com/spf13/viper"
 )
 
+const (
+	OutputFormatTable = "table"
+	OutputFormatJSON  = "json"
+	OutputFormatYAML  = "yaml"
+)
+
+var supportedOutputFormats = []string{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}
+
+// IsValidOutputFormat reports whether format is one of the supported output formats.
+func IsValidOutputFormat(format string) bool {
+	return slices.Contains(supportedOutputFormats, format)
+}
+
 type Config struct {
 	KAgentURL    string        `mapstructure:"kagent_url"`
 	Namespace    string        `mapstructure:"namespace"`
 	OutputFormat string        `mapstructure:"output_format"`
 	Verbose      bool          `mapstructure:"verbose"`
 	Timeout      time.Duration `mapstructure:"timeout"`
+	// DefaultProvider and DefaultModel are used by commands that target a model
+	// when no provider/model is passed explicitly. Empty means unset.
+	DefaultProvider string `mapstructure:"default_provider"`
+	DefaultModel    string `mapstructure:"default_model"`
+}
+
+func (c *Config) Validate() error {
+	if !IsValidOutputFormat(c.OutputFormat) {
+		return fmt.Errorf("invalid output format %q: must be one of %s", c.OutputFormat, strings.Join(supportedOutputFormats, ", "))
+	}
+	return nil
 }
 
 func (c *Config) Client() *kagentclient.ClientSet {
-	return kagentclient.New(c.KAgentURL, kagentclient.WithUserID("admin@kagent.dev"))
+	return kagentclient.New(c.KAgentURL, kagentclient.WithUserID("admin@kagent.dev"), kagentclient.WithTimeout(c.Timeout))
 }
 
 func Init() error {
@@ -44,10 +70,10 @@ func Init() error {
 
 	// Set default values
 	viper.SetDefault("kagent_url", "http://localhost:8083")
-	viper.SetDefault("output_format", "table")
+	viper.SetDefault("output_format", OutputFormatTable)
 	viper.SetDefault("namespace", "kagent")
 	viper.SetDefault("timeout", 300*time.Second)
-	viper.MustBindEnv("USER_ID")
+	bindEnv()
 
 	if err := viper.ReadInConfig(); err != nil {
 		// If config file doesn't exist, create it with defaults
@@ -62,10 +88,19 @@ func Init() error {
 	return nil
 }
 
+func bindEnv() {
+	viper.MustBindEnv("USER_ID")
+	viper.MustBindEnv("default_provider", "KAGENT_DEFAULT_PROVIDER")
+	viper.MustBindEnv("default_model", "KAGENT_DEFAULT_MODEL")
+}
+
 func Get() (*Config, error) {
 	var config Config
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("error unmarshaling config: %w", err)
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 	return &config, nil
 }
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestGetDefaultModelFromViper(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	bindEnv()
+	viper.Set("output_format", OutputFormatTable)
+
+	viper.Set("default_provider", "OpenAI")
+	viper.Set("default_model", "gpt-4o")
+
+	cfg, err := Get()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if cfg.DefaultProvider != "OpenAI" {
+		t.Fatalf("Expected DefaultProvider OpenAI, but got %q", cfg.DefaultProvider)
+	}
+	if cfg.DefaultModel != "gpt-4o" {
+		t.Fatalf("Expected DefaultModel gpt-4o, but got %q", cfg.DefaultModel)
+	}
+}
+
+func TestGetDefaultModelFromEnv(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	bindEnv()
+	viper.Set("output_format", OutputFormatTable)
+
+	t.Setenv("KAGENT_DEFAULT_PROVIDER", "Anthropic")
+	t.Setenv("KAGENT_DEFAULT_MODEL", "claude-sonnet-4-5")
+
+	cfg, err := Get()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if cfg.DefaultProvider != "Anthropic" {
+		t.Fatalf("Expected DefaultProvider Anthropic, but got %q", cfg.DefaultProvider)
+	}
+	if cfg.DefaultModel != "claude-sonnet-4-5" {
+		t.Fatalf("Expected DefaultModel claude-sonnet-4-5, but got %q", cfg.DefaultModel)
+	}
+}
+
+func TestGetDefaultModelUnset(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	bindEnv()
+	viper.Set("output_format", OutputFormatTable)
+
+	cfg, err := Get()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if cfg.DefaultProvider != "" || cfg.DefaultModel != "" {
+		t.Fatalf("Expected unset defaults, but got provider %q and model %q", cfg.DefaultProvider, cfg.DefaultModel)
+	}
+}
+
+func TestIsValidOutputFormat(t *testing.T) {
+	for _, format := range []string{OutputFormatTable, OutputFormatJSON, OutputFormatYAML} {
+		if !IsValidOutputFormat(format) {
+			t.Fatalf("Expected %q to be a valid output format", format)
+		}
+	}
+	for _, format := range []string{"", "jsn", "JSON", "xml"} {
+		if IsValidOutputFormat(format) {
+			t.Fatalf("Expected %q to be an invalid output format", format)
+		}
+	}
+}
+
+func TestGetValidatesOutputFormat(t *testing.T) {
+	for _, format := range []string{OutputFormatTable, OutputFormatJSON, OutputFormatYAML} {
+		t.Run(format, func(t *testing.T) {
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+			viper.Set("output_format", format)
+
+			cfg, err := Get()
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if cfg.OutputFormat != format {
+				t.Fatalf("Expected output format %q, but got %q", format, cfg.OutputFormat)
+			}
+		})
+	}
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("output_format", "jsn")
+
+	_, err := Get()
+	if err == nil {
+		t.Fatalf("Expected error, but got nil")
+	}
+	expected := `invalid output format "jsn": must be one of table, json, yaml`
+	if err.Error() != expected {
+		t.Fatalf("Expected error %q, but got %q", expected, err.Error())
+	}
+}
@@ -0,0 +1,68 @@
+package adk
+
+// ModelDefaults holds the values ParseModelWithDefaults applies to optional
+// fields that are unset on a parsed model. A nil field means no default.
+type ModelDefaults struct {
+	MaxTokens *int
+	// Timeout is the request timeout in seconds
+	Timeout *int
+}
+
+// modelDefaults are the per-type defaults. Only fields the agent runtime
+// passes on to the provider are set, and only where the provider needs one:
+//   - Anthropic's Messages API rejects requests without max_tokens.
+//   - Ollama runs through LiteLLM, whose default timeout is well over an hour,
+//     so a stalled local server would hang the agent. 300s still leaves time
+//     to load the model on the first request.
+var modelDefaults = map[string]ModelDefaults{
+	ModelTypeAnthropic: {MaxTokens: ptrTo(4096)},
+	ModelTypeOllama:    {Timeout: ptrTo(300)},
+}
+
+// DefaultsForModelType returns the defaults applied to models of the given type.
+func DefaultsForModelType(modelType string) ModelDefaults {
+	return modelDefaults[modelType]
+}
+
+// ParseModelWithDefaults parses a model like ParseModel and then fills unset
+// optional fields from the defaults for its type. Values present in the input
+// are never changed.
+func ParseModelWithDefaults(bytes []byte) (Model, error) {
+	model, err := ParseModel(bytes)
+	if err != nil {
+		return nil, err
+	}
+	applyModelDefaults(model, DefaultsForModelType(model.GetType()))
+	return model, nil
+}
+
+func applyModelDefaults(model Model, defaults ModelDefaults) {
+	switch m := model.(type) {
+	case *OpenAI:
+		setDefault(&m.MaxTokens, defaults.MaxTokens)
+		setDefault(&m.Timeout, defaults.Timeout)
+	case *Anthropic:
+		setDefault(&m.MaxTokens, defaults.MaxTokens)
+		setDefault(&m.Timeout, defaults.Timeout)
+	case *AzureOpenAI:
+		setDefault(&m.Timeout, defaults.Timeout)
+	case *Ollama:
+		setDefault(&m.Timeout, defaults.Timeout)
+	case *Bedrock:
+		setDefault(&m.Timeout, defaults.Timeout)
+	}
+}
+
+// setDefault copies def into *field when the field is unset, so the parsed
+// model never shares a pointer with the defaults table.
+func setDefault[T any](field **T, def *T) {
+	if *field != nil || def == nil {
+		return
+	}
+	v := *def
+	*field = &v
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
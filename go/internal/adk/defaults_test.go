@@ -0,0 +1,68 @@
+package adk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModelWithDefaultsFillsUnsetFields(t *testing.T) {
+	model, err := ParseModelWithDefaults([]byte(`{"type":"anthropic","model":"claude-sonnet-4-5"}`))
+	require.NoError(t, err)
+
+	anthropic, ok := model.(*Anthropic)
+	require.True(t, ok)
+	require.NotNil(t, anthropic.MaxTokens)
+	assert.Equal(t, 4096, *anthropic.MaxTokens)
+	assert.Nil(t, anthropic.Timeout, "no default is defined for the anthropic timeout")
+	assert.Nil(t, anthropic.ParallelToolCalls)
+}
+
+func TestParseModelWithDefaultsKeepsExplicitValues(t *testing.T) {
+	model, err := ParseModelWithDefaults([]byte(`{"type":"anthropic","model":"claude-sonnet-4-5","max_tokens":1024}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1024, *model.(*Anthropic).MaxTokens)
+
+	model, err = ParseModelWithDefaults([]byte(`{"type":"ollama","model":"llama3","timeout":5}`))
+	require.NoError(t, err)
+	assert.Equal(t, 5, *model.(*Ollama).Timeout)
+}
+
+func TestParseModelWithDefaultsIsProviderSpecific(t *testing.T) {
+	model, err := ParseModelWithDefaults([]byte(`{"type":"ollama","model":"llama3"}`))
+	require.NoError(t, err)
+	require.NotNil(t, model.(*Ollama).Timeout)
+	assert.Equal(t, 300, *model.(*Ollama).Timeout)
+
+	// OpenAI needs no defaults, so the parsed model must serialize unchanged
+	input := `{"type":"openai","model":"gpt-4o","base_url":""}`
+	model, err = ParseModelWithDefaults([]byte(input))
+	require.NoError(t, err)
+	data, err := json.Marshal(model)
+	require.NoError(t, err)
+	assert.JSONEq(t, input, string(data))
+}
+
+func TestParseModelWithDefaultsDoesNotShareDefaults(t *testing.T) {
+	model, err := ParseModelWithDefaults([]byte(`{"type":"anthropic","model":"claude-sonnet-4-5"}`))
+	require.NoError(t, err)
+	*model.(*Anthropic).MaxTokens = 0
+
+	assert.Equal(t, 4096, *DefaultsForModelType(ModelTypeAnthropic).MaxTokens)
+}
+
+func TestParseModelStaysPure(t *testing.T) {
+	model, err := ParseModel([]byte(`{"type":"anthropic","model":"claude-sonnet-4-5"}`))
+	require.NoError(t, err)
+
+	anthropic := model.(*Anthropic)
+	assert.Nil(t, anthropic.MaxTokens)
+	assert.Nil(t, anthropic.Timeout)
+}
+
+func TestParseModelWithDefaultsUnknownType(t *testing.T) {
+	_, err := ParseModelWithDefaults([]byte(`{"type":"unknown"}`))
+	assert.EqualError(t, err, "unknown model type: unknown")
+}
@@ -0,0 +1,83 @@
+package adk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type EmbeddingModel interface {
+	GetType() string
+}
+
+type BaseEmbeddingModel struct {
+	Type    string            `json:"type"`
+	Model   string            `json:"model"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type OpenAIEmbedding struct {
+	BaseEmbeddingModel
+	BaseUrl        string `json:"base_url,omitempty"`
+	Dimensions     *int   `json:"dimensions,omitempty"`
+	EncodingFormat string `json:"encoding_format,omitempty"`
+}
+
+func (o *OpenAIEmbedding) MarshalJSON() ([]byte, error) {
+	type Alias OpenAIEmbedding
+
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  ModelTypeOpenAI,
+		Alias: (*Alias)(o),
+	})
+}
+
+func (o *OpenAIEmbedding) GetType() string {
+	return ModelTypeOpenAI
+}
+
+type OllamaEmbedding struct {
+	BaseEmbeddingModel
+	Dimensions *int              `json:"dimensions,omitempty"`
+	Options    map[string]string `json:"options,omitempty"`
+}
+
+func (o *OllamaEmbedding) MarshalJSON() ([]byte, error) {
+	type Alias OllamaEmbedding
+
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  ModelTypeOllama,
+		Alias: (*Alias)(o),
+	})
+}
+
+func (o *OllamaEmbedding) GetType() string {
+	return ModelTypeOllama
+}
+
+func ParseEmbeddingModel(bytes []byte) (EmbeddingModel, error) {
+	var model BaseEmbeddingModel
+	if err := json.Unmarshal(bytes, &model); err != nil {
+		return nil, err
+	}
+	switch model.Type {
+	case ModelTypeOpenAI:
+		var openai OpenAIEmbedding
+		if err := json.Unmarshal(bytes, &openai); err != nil {
+			return nil, err
+		}
+		return &openai, nil
+	case ModelTypeOllama:
+		var ollama OllamaEmbedding
+		if err := json.Unmarshal(bytes, &ollama); err != nil {
+			return nil, err
+		}
+		return &ollama, nil
+	}
+	return nil, fmt.Errorf("unknown embedding model type: %s", model.Type)
+}
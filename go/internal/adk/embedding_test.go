@@ -0,0 +1,63 @@
+package adk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddingModelRoundtrip(t *testing.T) {
+	dimensions := 1536
+
+	models := []EmbeddingModel{
+		&OpenAIEmbedding{
+			BaseEmbeddingModel: BaseEmbeddingModel{Type: ModelTypeOpenAI, Model: "text-embedding-3-small"},
+			BaseUrl:            "https://api.openai.com/v1",
+			Dimensions:         &dimensions,
+			EncodingFormat:     "float",
+		},
+		&OllamaEmbedding{
+			BaseEmbeddingModel: BaseEmbeddingModel{Type: ModelTypeOllama, Model: "nomic-embed-text"},
+			Dimensions:         &dimensions,
+			Options:            map[string]string{"num_ctx": "2048"},
+		},
+	}
+
+	for _, model := range models {
+		t.Run(model.GetType(), func(t *testing.T) {
+			data, err := json.Marshal(model)
+			require.NoError(t, err)
+
+			parsed, err := ParseEmbeddingModel(data)
+			require.NoError(t, err)
+			assert.Equal(t, model, parsed)
+		})
+	}
+}
+
+func TestEmbeddingModelDimensionsOmitted(t *testing.T) {
+	models := []EmbeddingModel{
+		&OpenAIEmbedding{BaseEmbeddingModel: BaseEmbeddingModel{Model: "text-embedding-3-small"}},
+		&OllamaEmbedding{BaseEmbeddingModel: BaseEmbeddingModel{Model: "nomic-embed-text"}},
+	}
+
+	for _, model := range models {
+		data, err := json.Marshal(model)
+		require.NoError(t, err)
+
+		var raw map[string]any
+		require.NoError(t, json.Unmarshal(data, &raw))
+		assert.Equal(t, model.GetType(), raw["type"])
+		assert.NotContains(t, raw, "dimensions", model.GetType())
+	}
+}
+
+func TestParseEmbeddingModelUnknownType(t *testing.T) {
+	_, err := ParseEmbeddingModel([]byte(`{"type":"anthropic","model":"claude-sonnet-4-5"}`))
+	assert.EqualError(t, err, "unknown embedding model type: anthropic")
+
+	_, err = ParseEmbeddingModel([]byte(`not json`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package adk
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kagent-dev/kagent/go/api/v1alpha2"
+)
+
+var providerModelTypes = map[v1alpha2.ModelProvider]string{
+	v1alpha2.ModelProviderOpenAI:            ModelTypeOpenAI,
+	v1alpha2.ModelProviderAzureOpenAI:       ModelTypeAzureOpenAI,
+	v1alpha2.ModelProviderAnthropic:         ModelTypeAnthropic,
+	v1alpha2.ModelProviderGeminiVertexAI:    ModelTypeGeminiVertexAI,
+	v1alpha2.ModelProviderAnthropicVertexAI: ModelTypeGeminiAnthropic,
+	v1alpha2.ModelProviderOllama:            ModelTypeOllama,
+	v1alpha2.ModelProviderGemini:            ModelTypeGemini,
+	v1alpha2.ModelProviderBedrock:           ModelTypeBedrock,
+}
+
+var modelTypeProviders = func() map[string]v1alpha2.ModelProvider {
+	m := make(map[string]v1alpha2.ModelProvider, len(providerModelTypes))
+	for provider, modelType := range providerModelTypes {
+		m[modelType] = provider
+	}
+	return m
+}()
+
+// ModelTypeForProvider returns the ADK model type used for the given ModelConfig provider.
+func ModelTypeForProvider(provider v1alpha2.ModelProvider) (string, error) {
+	modelType, ok := providerModelTypes[provider]
+	if !ok {
+		return "", fmt.Errorf("unknown model provider: %s", provider)
+	}
+	return modelType, nil
+}
+
+// ProviderForModelType returns the ModelConfig provider that produces the given ADK model type.
+func ProviderForModelType(modelType string) (v1alpha2.ModelProvider, error) {
+	provider, ok := modelTypeProviders[modelType]
+	if !ok {
+		return "", fmt.Errorf("unknown model type: %s", modelType)
+	}
+	return provider, nil
+}
+
+// bedrockInferenceProfilePrefixes are the geography prefixes Bedrock adds to
+// cross-region inference profile IDs (e.g. us.anthropic.claude-...).
+var bedrockInferenceProfilePrefixes = []string{"us.", "eu.", "apac.", "global."}
+
+// bedrockRevisionSuffix matches the default ":0" revision after a "-vN" version.
+var bedrockRevisionSuffix = regexp.MustCompile(`(-v\d+):0$`)
+
+// NormalizeModelID returns a display name for a provider model ID. The raw ID
+// must still be used when calling the provider; only suffixes and prefixes
+// that do not change which model is meant are removed.
+func NormalizeModelID(provider v1alpha2.ModelProvider, id string) string {
+	id = strings.TrimSpace(id)
+	switch provider {
+	case v1alpha2.ModelProviderOllama:
+		// llama3:latest and llama3 refer to the same tag, llama3:70b does not
+		return strings.TrimSuffix(id, ":latest")
+	case v1alpha2.ModelProviderBedrock:
+		// arn:aws:bedrock:<region>::foundation-model/<model-id>
+		if strings.HasPrefix(id, "arn:") {
+			if i := strings.LastIndex(id, "/"); i >= 0 {
+				id = id[i+1:]
+			}
+		}
+		for _, prefix := range bedrockInferenceProfilePrefixes {
+			if strings.HasPrefix(id, prefix) {
+				id = strings.TrimPrefix(id, prefix)
+				break
+			}
+		}
+		// anthropic.claude-3-haiku-20240307-v1:0 -> anthropic.claude-3-haiku-20240307-v1.
+		// Only the ":0" revision of a versioned ID is dropped; other suffixes
+		// name a different model (anthropic.claude-v2:1 is Claude 2.1).
+		return bedrockRevisionSuffix.ReplaceAllString(id, "$1")
+	}
+	return id
+}
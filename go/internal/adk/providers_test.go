@@ -0,0 +1,92 @@
+package adk
+
+import (
+	"testing"
+
+	"github.com/kagent-dev/kagent/go/api/v1alpha2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelTypeForProvider(t *testing.T) {
+	tests := []struct {
+		provider  v1alpha2.ModelProvider
+		modelType string
+		model     Model
+	}{
+		{v1alpha2.ModelProviderOpenAI, ModelTypeOpenAI, &OpenAI{}},
+		{v1alpha2.ModelProviderAzureOpenAI, ModelTypeAzureOpenAI, &AzureOpenAI{}},
+		{v1alpha2.ModelProviderAnthropic, ModelTypeAnthropic, &Anthropic{}},
+		{v1alpha2.ModelProviderGeminiVertexAI, ModelTypeGeminiVertexAI, &GeminiVertexAI{}},
+		{v1alpha2.ModelProviderAnthropicVertexAI, ModelTypeGeminiAnthropic, &GeminiAnthropic{}},
+		{v1alpha2.ModelProviderOllama, ModelTypeOllama, &Ollama{}},
+		{v1alpha2.ModelProviderGemini, ModelTypeGemini, &Gemini{}},
+		{v1alpha2.ModelProviderBedrock, ModelTypeBedrock, &Bedrock{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.provider), func(t *testing.T) {
+			modelType, err := ModelTypeForProvider(tt.provider)
+			require.NoError(t, err)
+			assert.Equal(t, tt.modelType, modelType)
+			assert.Equal(t, tt.model.GetType(), modelType)
+
+			provider, err := ProviderForModelType(modelType)
+			require.NoError(t, err)
+			assert.Equal(t, tt.provider, provider)
+		})
+	}
+}
+
+func TestModelTypeForProviderUnknown(t *testing.T) {
+	_, err := ModelTypeForProvider("Unknown")
+	assert.Error(t, err)
+
+	_, err = ProviderForModelType("unknown")
+	assert.Error(t, err)
+}
+
+// TestModelTypeForProviderCoversEnum fails when a provider is added to
+// v1alpha2.ModelProviders without an ADK model type mapping.
+func TestModelTypeForProviderCoversEnum(t *testing.T) {
+	for _, provider := range v1alpha2.ModelProviders {
+		_, err := ModelTypeForProvider(provider)
+		assert.NoError(t, err, "missing ADK model type mapping for provider %s", provider)
+	}
+	assert.Len(t, providerModelTypes, len(v1alpha2.ModelProviders))
+}
+
+func TestNormalizeModelID(t *testing.T) {
+	tests := []struct {
+		provider   v1alpha2.ModelProvider
+		raw        string
+		normalized string
+	}{
+		{v1alpha2.ModelProviderOllama, "llama3:latest", "llama3"},
+		{v1alpha2.ModelProviderOllama, "llama2:13b", "llama2:13b"},
+		{v1alpha2.ModelProviderOllama, "mistral", "mistral"},
+		{v1alpha2.ModelProviderOllama, " qwen2.5:latest ", "qwen2.5"},
+		{v1alpha2.ModelProviderBedrock, "anthropic.claude-v2:1", "anthropic.claude-v2:1"},
+		{v1alpha2.ModelProviderBedrock, "anthropic.claude-v2", "anthropic.claude-v2"},
+		{v1alpha2.ModelProviderBedrock, "us.anthropic.claude-3-5-haiku-20241022-v1:0", "anthropic.claude-3-5-haiku-20241022-v1"},
+		{v1alpha2.ModelProviderBedrock, "global.anthropic.claude-sonnet-4-5-20250929-v1:0", "anthropic.claude-sonnet-4-5-20250929-v1"},
+		{v1alpha2.ModelProviderBedrock, "arn:aws:bedrock:us-east-1::foundation-model/anthropic.claude-3-sonnet-20240229-v1:0", "anthropic.claude-3-sonnet-20240229-v1"},
+		{v1alpha2.ModelProviderBedrock, "anthropic.claude-3-sonnet-20240229-v1:0:200k", "anthropic.claude-3-sonnet-20240229-v1:0:200k"},
+		{v1alpha2.ModelProviderBedrock, "amazon.titan-text-express-v1", "amazon.titan-text-express-v1"},
+		{v1alpha2.ModelProviderOpenAI, "gpt-4o:latest", "gpt-4o:latest"},
+		{v1alpha2.ModelProviderAnthropicVertexAI, "claude-opus-4-1@20250805", "claude-opus-4-1@20250805"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.provider)+"/"+tt.raw, func(t *testing.T) {
+			assert.Equal(t, tt.normalized, NormalizeModelID(tt.provider, tt.raw))
+		})
+	}
+}
+
+func TestNormalizeModelIDKeepsDistinctBedrockModels(t *testing.T) {
+	// Claude 2.1 and Claude 2.0 must not collapse to the same ID
+	claude21 := NormalizeModelID(v1alpha2.ModelProviderBedrock, "anthropic.claude-v2:1")
+	claude20 := NormalizeModelID(v1alpha2.ModelProviderBedrock, "anthropic.claude-v2")
+	assert.NotEqual(t, claude20, claude21)
+}
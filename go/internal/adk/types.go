@@ -1,10 +1,14 @@
 package adk
 
 import (
+	"crypto/sha256"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strings"
 )
 
 type StreamableHTTPConnectionParams struct {
@@ -38,6 +42,20 @@ type Model interface {
 	GetType() string
 }
 
+// Validator is implemented by models whose settings can be invalid in ways the
+// JSON shape alone does not catch. ParseModel and the agent translator call it.
+type Validator interface {
+	Validate() error
+}
+
+// ValidateModel runs the model's Validate method if it has one.
+func ValidateModel(model Model) error {
+	if v, ok := model.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
 type BaseModel struct {
 	Type    string            `json:"type"`
 	Model   string            `json:"model"`
@@ -61,6 +79,87 @@ type OpenAI struct {
 	Temperature      *float64 `json:"temperature,omitempty"`
 	Timeout          *int     `json:"timeout,omitempty"`
 	TopP             *float64 `json:"top_p,omitempty"`
+
+	ResponseFormat    *OpenAIResponseFormat `json:"response_format,omitempty"`
+	ParallelToolCalls *bool                 `json:"parallel_tool_calls,omitempty"`
+	ToolChoice        *OpenAIToolChoice     `json:"tool_choice,omitempty"`
+}
+
+const (
+	ResponseFormatText       = "text"
+	ResponseFormatJSONObject = "json_object"
+	ResponseFormatJSONSchema = "json_schema"
+)
+
+// OpenAIResponseFormat mirrors the OpenAI `response_format` request parameter.
+// JSONSchema is only used (and required) when Type is json_schema.
+type OpenAIResponseFormat struct {
+	Type       string         `json:"type"`
+	JSONSchema map[string]any `json:"json_schema,omitempty"`
+}
+
+// OpenAI tool_choice types
+const (
+	ToolChoiceAuto     = "auto"
+	ToolChoiceNone     = "none"
+	ToolChoiceRequired = "required"
+	ToolChoiceFunction = "function"
+)
+
+// OpenAIToolChoice mirrors OpenAI's `tool_choice` parameter. The modes auto,
+// none and required are serialized as a bare string. Type function forces the
+// named function and is serialized as {"type":"function","function":{"name":...}}.
+type OpenAIToolChoice struct {
+	Type string
+	Name string
+}
+
+type openAIToolChoiceFunction struct {
+	Type     string `json:"type"`
+	Function struct {
+		Name string `json:"name"`
+	} `json:"function"`
+}
+
+func (t OpenAIToolChoice) MarshalJSON() ([]byte, error) {
+	if t.Type != ToolChoiceFunction {
+		return json.Marshal(t.Type)
+	}
+	var obj openAIToolChoiceFunction
+	obj.Type = ToolChoiceFunction
+	obj.Function.Name = t.Name
+	return json.Marshal(obj)
+}
+
+func (t *OpenAIToolChoice) UnmarshalJSON(data []byte) error {
+	var mode string
+	if err := json.Unmarshal(data, &mode); err == nil {
+		*t = OpenAIToolChoice{Type: mode}
+		return nil
+	}
+	var obj openAIToolChoiceFunction
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*t = OpenAIToolChoice{Type: obj.Type, Name: obj.Function.Name}
+	return nil
+}
+
+func (t *OpenAIToolChoice) Validate() error {
+	switch t.Type {
+	case ToolChoiceAuto, ToolChoiceNone, ToolChoiceRequired:
+		if t.Name != "" {
+			return fmt.Errorf("tool_choice name is only allowed with type %s", ToolChoiceFunction)
+		}
+	case ToolChoiceFunction:
+		if t.Name == "" {
+			return fmt.Errorf("tool_choice name is required with type %s", ToolChoiceFunction)
+		}
+	default:
+		return fmt.Errorf("invalid tool_choice type %q: must be one of %s, %s, %s, %s",
+			t.Type, ToolChoiceAuto, ToolChoiceNone, ToolChoiceRequired, ToolChoiceFunction)
+	}
+	return nil
 }
 
 const (
@@ -90,8 +189,39 @@ func (o *OpenAI) GetType() string {
 	return ModelTypeOpenAI
 }
 
+var _ Validator = &OpenAI{}
+
+// Validate checks tool_choice and that response_format uses a known type with
+// json_schema set exactly when the type is json_schema.
+func (o *OpenAI) Validate() error {
+	if o.ToolChoice != nil {
+		if err := o.ToolChoice.Validate(); err != nil {
+			return err
+		}
+	}
+	if o.ResponseFormat == nil {
+		return nil
+	}
+	switch o.ResponseFormat.Type {
+	case ResponseFormatText, ResponseFormatJSONObject:
+		if o.ResponseFormat.JSONSchema != nil {
+			return fmt.Errorf("response_format json_schema is only allowed with type %s", ResponseFormatJSONSchema)
+		}
+	case ResponseFormatJSONSchema:
+		if o.ResponseFormat.JSONSchema == nil {
+			return fmt.Errorf("response_format json_schema is required with type %s", ResponseFormatJSONSchema)
+		}
+	default:
+		return fmt.Errorf("invalid response_format type %q: must be one of %s, %s, %s",
+			o.ResponseFormat.Type, ResponseFormatText, ResponseFormatJSONObject, ResponseFormatJSONSchema)
+	}
+	return nil
+}
+
 type AzureOpenAI struct {
 	BaseModel
+	// Timeout is the request timeout in seconds
+	Timeout *int `json:"timeout,omitempty"`
 }
 
 func (a *AzureOpenAI) GetType() string {
@@ -99,31 +229,77 @@ func (a *AzureOpenAI) GetType() string {
 }
 
 func (a *AzureOpenAI) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]any{
+	data := map[string]any{
 		"type":    ModelTypeAzureOpenAI,
 		"model":   a.Model,
 		"headers": a.Headers,
-	})
+	}
+	if a.Timeout != nil {
+		data["timeout"] = *a.Timeout
+	}
+	return json.Marshal(data)
 }
 
 type Anthropic struct {
 	BaseModel
 	BaseUrl string `json:"base_url"`
+	// The agent runtime calls Anthropic through LiteLLM, which takes the
+	// OpenAI-style tool settings and translates them to Anthropic's
+	// tool_choice (including disable_parallel_tool_use).
+	ParallelToolCalls *bool             `json:"parallel_tool_calls,omitempty"`
+	ToolChoice        *OpenAIToolChoice `json:"tool_choice,omitempty"`
+	MaxTokens         *int              `json:"max_tokens,omitempty"`
+	Temperature       *float64          `json:"temperature,omitempty"`
+	TopP              *float64          `json:"top_p,omitempty"`
+	TopK              *int              `json:"top_k,omitempty"`
+	// Timeout is the request timeout in seconds
+	Timeout *int `json:"timeout,omitempty"`
 }
 
 func (a *Anthropic) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]any{
+	data := map[string]any{
 		"type":     ModelTypeAnthropic,
 		"model":    a.Model,
 		"base_url": a.BaseUrl,
 		"headers":  a.Headers,
-	})
+	}
+	if a.ParallelToolCalls != nil {
+		data["parallel_tool_calls"] = *a.ParallelToolCalls
+	}
+	if a.ToolChoice != nil {
+		data["tool_choice"] = a.ToolChoice
+	}
+	if a.MaxTokens != nil {
+		data["max_tokens"] = *a.MaxTokens
+	}
+	if a.Temperature != nil {
+		data["temperature"] = *a.Temperature
+	}
+	if a.TopP != nil {
+		data["top_p"] = *a.TopP
+	}
+	if a.TopK != nil {
+		data["top_k"] = *a.TopK
+	}
+	if a.Timeout != nil {
+		data["timeout"] = *a.Timeout
+	}
+	return json.Marshal(data)
 }
 
 func (a *Anthropic) GetType() string {
 	return ModelTypeAnthropic
 }
 
+var _ Validator = &Anthropic{}
+
+func (a *Anthropic) Validate() error {
+	if a.ToolChoice != nil {
+		return a.ToolChoice.Validate()
+	}
+	return nil
+}
+
 type GeminiVertexAI struct {
 	BaseModel
 }
@@ -142,14 +318,19 @@ func (g *GeminiVertexAI) GetType() string {
 
 type GeminiAnthropic struct {
 	BaseModel
+	MaxTokens *int `json:"max_tokens,omitempty"`
 }
 
 func (g *GeminiAnthropic) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]any{
+	data := map[string]any{
 		"type":    ModelTypeGeminiAnthropic,
 		"model":   g.Model,
 		"headers": g.Headers,
-	})
+	}
+	if g.MaxTokens != nil {
+		data["max_tokens"] = *g.MaxTokens
+	}
+	return json.Marshal(data)
 }
 
 func (g *GeminiAnthropic) GetType() string {
@@ -159,15 +340,21 @@ func (g *GeminiAnthropic) GetType() string {
 type Ollama struct {
 	BaseModel
 	Options map[string]string `json:"options,omitempty"`
+	// Timeout is the request timeout in seconds
+	Timeout *int `json:"timeout,omitempty"`
 }
 
 func (o *Ollama) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]any{
+	data := map[string]any{
 		"type":    ModelTypeOllama,
 		"model":   o.Model,
 		"headers": o.Headers,
 		"options": o.Options,
-	})
+	}
+	if o.Timeout != nil {
+		data["timeout"] = *o.Timeout
+	}
+	return json.Marshal(data)
 }
 
 func (o *Ollama) GetType() string {
@@ -194,6 +381,8 @@ type Bedrock struct {
 	BaseModel
 	// Region is the AWS region where the model is available
 	Region string `json:"region,omitempty"`
+	// Timeout is the request timeout in seconds
+	Timeout *int `json:"timeout,omitempty"`
 }
 
 func (b *Bedrock) MarshalJSON() ([]byte, error) {
@@ -205,6 +394,9 @@ func (b *Bedrock) MarshalJSON() ([]byte, error) {
 	if b.Region != "" {
 		data["region"] = b.Region
 	}
+	if b.Timeout != nil {
+		data["timeout"] = *b.Timeout
+	}
 	return json.Marshal(data)
 }
 
@@ -235,12 +427,18 @@ func ParseModel(bytes []byte) (Model, error) {
 		if err := json.Unmarshal(bytes, &openai); err != nil {
 			return nil, err
 		}
+		if err := openai.Validate(); err != nil {
+			return nil, err
+		}
 		return &openai, nil
 	case ModelTypeAnthropic:
 		var anthropic Anthropic
 		if err := json.Unmarshal(bytes, &anthropic); err != nil {
 			return nil, err
 		}
+		if err := anthropic.Validate(); err != nil {
+			return nil, err
+		}
 		return &anthropic, nil
 	case ModelTypeGeminiVertexAI:
 		var geminiVertexAI GeminiVertexAI
@@ -325,3 +523,84 @@ var _ driver.Valuer = &AgentConfig{}
 func (a AgentConfig) Value() (driver.Value, error) {
 	return json.Marshal(a)
 }
+
+// Fingerprint returns a stable hash of the model configuration. It is built
+// from the struct fields rather than the model's MarshalJSON, which omits
+// some fields (e.g. TLS settings) for most model types. The fields are
+// collected into a generic JSON value first so the result does not depend on
+// field or map key ordering.
+func Fingerprint(model Model) (string, error) {
+	v := reflect.ValueOf(model)
+	if !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return "", fmt.Errorf("cannot fingerprint a nil model")
+	}
+	fields := map[string]any{}
+	if err := collectJSONFields(reflect.Indirect(v), fields); err != nil {
+		return "", err
+	}
+	fields["type"] = model.GetType()
+
+	// encoding/json writes map keys in sorted order
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// collectJSONFields adds the json-tagged fields of a struct to fields,
+// flattening embedded structs. Empty omitempty fields are skipped so adding a
+// new optional field does not change existing fingerprints.
+func collectJSONFields(v reflect.Value, fields map[string]any) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			if err := collectJSONFields(v.Field(i), fields); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() || tag == "" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		value := v.Field(i)
+		if strings.Contains(opts, "omitempty") && isEmptyJSONValue(value) {
+			continue
+		}
+		data, err := json.Marshal(value.Interface())
+		if err != nil {
+			return err
+		}
+		var canonical any
+		if err := json.Unmarshal(data, &canonical); err != nil {
+			return err
+		}
+		fields[name] = canonical
+	}
+	return nil
+}
+
+// isEmptyJSONValue reports whether encoding/json treats v as empty for
+// omitempty. Unlike reflect.Value.IsZero, empty non-nil maps, slices and
+// strings count as empty, so nil and empty headers fingerprint the same.
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
@@ -0,0 +1,413 @@
+package adk
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIResponseFormatRoundtrip(t *testing.T) {
+	original := &OpenAI{
+		BaseModel: BaseModel{Model: "gpt-4o"},
+		ResponseFormat: &OpenAIResponseFormat{
+			Type: ResponseFormatJSONSchema,
+			JSONSchema: map[string]any{
+				"name":   "answer",
+				"strict": true,
+			},
+		},
+	}
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+
+	parsed, err := ParseModel(data)
+	require.NoError(t, err)
+
+	openai, ok := parsed.(*OpenAI)
+	require.True(t, ok)
+	require.NotNil(t, openai.ResponseFormat)
+	assert.Equal(t, ResponseFormatJSONSchema, openai.ResponseFormat.Type)
+	assert.Equal(t, "answer", openai.ResponseFormat.JSONSchema["name"])
+	assert.Equal(t, true, openai.ResponseFormat.JSONSchema["strict"])
+}
+
+func TestOpenAIResponseFormatOmitted(t *testing.T) {
+	data, err := json.Marshal(&OpenAI{BaseModel: BaseModel{Model: "gpt-4o"}})
+	require.NoError(t, err)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(data, &raw))
+	assert.NotContains(t, raw, "response_format")
+}
+
+func TestOpenAIValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseFormat *OpenAIResponseFormat
+		wantErr        bool
+	}{
+		{name: "unset", responseFormat: nil},
+		{name: "text", responseFormat: &OpenAIResponseFormat{Type: ResponseFormatText}},
+		{name: "json_object", responseFormat: &OpenAIResponseFormat{Type: ResponseFormatJSONObject}},
+		{
+			name: "json_schema with schema",
+			responseFormat: &OpenAIResponseFormat{
+				Type:       ResponseFormatJSONSchema,
+				JSONSchema: map[string]any{"name": "answer"},
+			},
+		},
+		{name: "json_schema without schema", responseFormat: &OpenAIResponseFormat{Type: ResponseFormatJSONSchema}, wantErr: true},
+		{
+			name: "schema with json_object",
+			responseFormat: &OpenAIResponseFormat{
+				Type:       ResponseFormatJSONObject,
+				JSONSchema: map[string]any{"name": "answer"},
+			},
+			wantErr: true,
+		},
+		{name: "unknown type", responseFormat: &OpenAIResponseFormat{Type: "xml"}, wantErr: true},
+		{name: "empty type", responseFormat: &OpenAIResponseFormat{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &OpenAI{BaseModel: BaseModel{Model: "gpt-4o"}, ResponseFormat: tt.responseFormat}
+			err := o.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseModelValidatesOpenAI(t *testing.T) {
+	_, err := ParseModel([]byte(`{"type":"openai","model":"gpt-4o","base_url":"","response_format":{"type":"xml"}}`))
+	assert.ErrorContains(t, err, `invalid response_format type "xml"`)
+
+	_, err = ParseModel([]byte(`{"type":"openai","model":"gpt-4o","base_url":"","response_format":{"type":"json_schema"}}`))
+	assert.Error(t, err)
+
+	model, err := ParseModel([]byte(`{"type":"openai","model":"gpt-4o","base_url":"","response_format":{"type":"json_object"}}`))
+	require.NoError(t, err)
+	assert.NoError(t, ValidateModel(model))
+}
+
+func TestValidateModelWithoutValidator(t *testing.T) {
+	assert.NoError(t, ValidateModel(&Gemini{BaseModel: BaseModel{Model: "gemini-2.5-flash"}}))
+	assert.Error(t, ValidateModel(&OpenAI{ResponseFormat: &OpenAIResponseFormat{Type: "xml"}}))
+}
+
+func TestToolSettingsRoundtrip(t *testing.T) {
+	parallel := false
+
+	tests := []struct {
+		name  string
+		model Model
+	}{
+		{
+			name: "openai with mode",
+			model: &OpenAI{
+				BaseModel:         BaseModel{Type: ModelTypeOpenAI, Model: "gpt-4o"},
+				ParallelToolCalls: &parallel,
+				ToolChoice:        &OpenAIToolChoice{Type: ToolChoiceRequired},
+			},
+		},
+		{
+			name: "openai with specific tool",
+			model: &OpenAI{
+				BaseModel:  BaseModel{Type: ModelTypeOpenAI, Model: "gpt-4o"},
+				ToolChoice: &OpenAIToolChoice{Type: ToolChoiceFunction, Name: "get_weather"},
+			},
+		},
+		{
+			name: "anthropic with mode",
+			model: &Anthropic{
+				BaseModel:         BaseModel{Type: ModelTypeAnthropic, Model: "claude-sonnet-4-5"},
+				ParallelToolCalls: &parallel,
+				ToolChoice:        &OpenAIToolChoice{Type: ToolChoiceRequired},
+			},
+		},
+		{
+			name: "anthropic with specific tool",
+			model: &Anthropic{
+				BaseModel:  BaseModel{Type: ModelTypeAnthropic, Model: "claude-sonnet-4-5"},
+				ToolChoice: &OpenAIToolChoice{Type: ToolChoiceFunction, Name: "get_weather"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.model)
+			require.NoError(t, err)
+
+			parsed, err := ParseModel(data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.model, parsed)
+		})
+	}
+}
+
+func TestOpenAIToolChoiceWireFormat(t *testing.T) {
+	tests := []struct {
+		choice OpenAIToolChoice
+		wire   string
+	}{
+		{OpenAIToolChoice{Type: ToolChoiceAuto}, `"auto"`},
+		{OpenAIToolChoice{Type: ToolChoiceNone}, `"none"`},
+		{OpenAIToolChoice{Type: ToolChoiceRequired}, `"required"`},
+		{OpenAIToolChoice{Type: ToolChoiceFunction, Name: "get_weather"}, `{"type":"function","function":{"name":"get_weather"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wire, func(t *testing.T) {
+			data, err := json.Marshal(tt.choice)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wire, string(data))
+
+			var parsed OpenAIToolChoice
+			require.NoError(t, json.Unmarshal([]byte(tt.wire), &parsed))
+			assert.Equal(t, tt.choice, parsed)
+		})
+	}
+}
+
+func TestToolChoiceInModelJSON(t *testing.T) {
+	data, err := json.Marshal(&OpenAI{
+		BaseModel:  BaseModel{Model: "gpt-4o"},
+		ToolChoice: &OpenAIToolChoice{Type: ToolChoiceFunction, Name: "get_weather"},
+	})
+	require.NoError(t, err)
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(data, &raw))
+	assert.Equal(t, map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}}, raw["tool_choice"])
+
+	// Anthropic models are called through LiteLLM, which takes the OpenAI
+	// shape and converts it to Anthropic's
+	data, err = json.Marshal(&Anthropic{
+		BaseModel:  BaseModel{Model: "claude-sonnet-4-5"},
+		ToolChoice: &OpenAIToolChoice{Type: ToolChoiceRequired},
+	})
+	require.NoError(t, err)
+	raw = nil
+	require.NoError(t, json.Unmarshal(data, &raw))
+	assert.Equal(t, "required", raw["tool_choice"])
+}
+
+func TestToolChoiceValidate(t *testing.T) {
+	assert.NoError(t, (&OpenAIToolChoice{Type: ToolChoiceRequired}).Validate())
+	assert.Error(t, (&OpenAIToolChoice{Type: "any"}).Validate(), "any is Anthropic's native name for required")
+	assert.Error(t, (&OpenAIToolChoice{Type: "tool", Name: "get_weather"}).Validate(), "tool is Anthropic's native name for function")
+	assert.Error(t, (&OpenAIToolChoice{Type: ToolChoiceFunction}).Validate())
+	assert.Error(t, (&OpenAIToolChoice{Type: ToolChoiceAuto, Name: "get_weather"}).Validate())
+
+	_, err := ParseModel([]byte(`{"type":"anthropic","model":"claude-sonnet-4-5","base_url":"","tool_choice":{"type":"any"}}`))
+	assert.Error(t, err)
+	_, err = ParseModel([]byte(`{"type":"openai","model":"gpt-4o","base_url":"","tool_choice":"any"}`))
+	assert.Error(t, err)
+}
+
+func TestToolSettingsOmitted(t *testing.T) {
+	models := []Model{
+		&OpenAI{BaseModel: BaseModel{Model: "gpt-4o"}},
+		&Anthropic{BaseModel: BaseModel{Model: "claude-sonnet-4-5"}},
+	}
+
+	for _, model := range models {
+		data, err := json.Marshal(model)
+		require.NoError(t, err)
+
+		var raw map[string]any
+		require.NoError(t, json.Unmarshal(data, &raw))
+		assert.NotContains(t, raw, "parallel_tool_calls", model.GetType())
+		assert.NotContains(t, raw, "tool_choice", model.GetType())
+	}
+}
+
+func TestTimeoutRoundtrip(t *testing.T) {
+	timeout := 120
+
+	models := []Model{
+		&OpenAI{BaseModel: BaseModel{Type: ModelTypeOpenAI, Model: "gpt-4o"}, Timeout: &timeout},
+		&AzureOpenAI{BaseModel: BaseModel{Type: ModelTypeAzureOpenAI, Model: "gpt-4o"}, Timeout: &timeout},
+		&Anthropic{BaseModel: BaseModel{Type: ModelTypeAnthropic, Model: "claude-sonnet-4-5"}, Timeout: &timeout},
+		&Ollama{BaseModel: BaseModel{Type: ModelTypeOllama, Model: "llama3"}, Timeout: &timeout},
+		&Bedrock{BaseModel: BaseModel{Type: ModelTypeBedrock, Model: "anthropic.claude-3-sonnet-20240229-v1:0"}, Timeout: &timeout},
+	}
+
+	for _, model := range models {
+		t.Run(model.GetType(), func(t *testing.T) {
+			data, err := json.Marshal(model)
+			require.NoError(t, err)
+
+			var raw map[string]any
+			require.NoError(t, json.Unmarshal(data, &raw))
+			assert.Equal(t, float64(timeout), raw["timeout"])
+
+			parsed, err := ParseModel(data)
+			require.NoError(t, err)
+			assert.Equal(t, model, parsed)
+		})
+	}
+}
+
+func TestTimeoutOmitted(t *testing.T) {
+	models := []Model{
+		&OpenAI{BaseModel: BaseModel{Model: "gpt-4o"}},
+		&AzureOpenAI{BaseModel: BaseModel{Model: "gpt-4o"}},
+		&Anthropic{BaseModel: BaseModel{Model: "claude-sonnet-4-5"}},
+		&Ollama{BaseModel: BaseModel{Model: "llama3"}},
+		&Bedrock{BaseModel: BaseModel{Model: "anthropic.claude-3-sonnet-20240229-v1:0"}},
+	}
+
+	for _, model := range models {
+		data, err := json.Marshal(model)
+		require.NoError(t, err)
+
+		var raw map[string]any
+		require.NoError(t, json.Unmarshal(data, &raw))
+		assert.NotContains(t, raw, "timeout", model.GetType())
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	temperature := 0.2
+
+	newModel := func(headers map[string]string) *OpenAI {
+		return &OpenAI{
+			BaseModel: BaseModel{
+				Model:   "gpt-4o",
+				Headers: headers,
+			},
+			Temperature: &temperature,
+		}
+	}
+
+	headers := map[string]string{}
+	reordered := map[string]string{}
+	keys := []string{"x-a", "x-b", "x-c", "x-d", "x-e"}
+	for i, k := range keys {
+		headers[k] = k
+		reordered[keys[len(keys)-1-i]] = keys[len(keys)-1-i]
+	}
+
+	base, err := Fingerprint(newModel(headers))
+	require.NoError(t, err)
+	assert.NotEmpty(t, base)
+
+	same, err := Fingerprint(newModel(reordered))
+	require.NoError(t, err)
+	assert.Equal(t, base, same)
+
+	changedModel := newModel(headers)
+	otherTemperature := 0.3
+	changedModel.Temperature = &otherTemperature
+	changed, err := Fingerprint(changedModel)
+	require.NoError(t, err)
+	assert.NotEqual(t, base, changed)
+
+	changedHeaders := newModel(map[string]string{"x-a": "other"})
+	changed, err = Fingerprint(changedHeaders)
+	require.NoError(t, err)
+	assert.NotEqual(t, base, changed)
+}
+
+func TestFingerprintDistinguishesTypes(t *testing.T) {
+	openai, err := Fingerprint(&OpenAI{BaseModel: BaseModel{Model: "llama3"}})
+	require.NoError(t, err)
+	ollama, err := Fingerprint(&Ollama{BaseModel: BaseModel{Model: "llama3"}})
+	require.NoError(t, err)
+	assert.NotEqual(t, openai, ollama)
+}
+
+func TestFingerprintIncludesFieldsDroppedByMarshalJSON(t *testing.T) {
+	disable := true
+	caPath := "/etc/ssl/certs/ca.crt"
+
+	models := []func() Model{
+		func() Model { return &Anthropic{BaseModel: BaseModel{Model: "claude-sonnet-4-5"}} },
+		func() Model { return &AzureOpenAI{BaseModel: BaseModel{Model: "gpt-4o"}} },
+		func() Model { return &Gemini{BaseModel: BaseModel{Model: "gemini-2.5-flash"}} },
+		func() Model { return &GeminiVertexAI{BaseModel: BaseModel{Model: "gemini-2.5-pro"}} },
+		func() Model { return &GeminiAnthropic{BaseModel: BaseModel{Model: "claude-sonnet-4@20250514"}} },
+		func() Model { return &Ollama{BaseModel: BaseModel{Model: "llama3"}} },
+	}
+
+	for _, newModel := range models {
+		t.Run(newModel().GetType(), func(t *testing.T) {
+			base, err := Fingerprint(newModel())
+			require.NoError(t, err)
+
+			withTLS := newModel()
+			baseModel := reflect.ValueOf(withTLS).Elem().FieldByName("BaseModel").Addr().Interface().(*BaseModel)
+			baseModel.TLSDisableVerify = &disable
+			changed, err := Fingerprint(withTLS)
+			require.NoError(t, err)
+			assert.NotEqual(t, base, changed, "tls_disable_verify should change the fingerprint")
+
+			baseModel.TLSCACertPath = &caPath
+			changedAgain, err := Fingerprint(withTLS)
+			require.NoError(t, err)
+			assert.NotEqual(t, changed, changedAgain, "tls_ca_cert_path should change the fingerprint")
+		})
+	}
+}
+
+func TestFingerprintTreatsNilAndEmptyMapsAlike(t *testing.T) {
+	nilHeaders, err := Fingerprint(&OpenAI{BaseModel: BaseModel{Model: "gpt-4o"}})
+	require.NoError(t, err)
+	emptyHeaders, err := Fingerprint(&OpenAI{BaseModel: BaseModel{Model: "gpt-4o", Headers: map[string]string{}}})
+	require.NoError(t, err)
+	assert.Equal(t, nilHeaders, emptyHeaders)
+
+	nilOptions, err := Fingerprint(&Ollama{BaseModel: BaseModel{Model: "llama3"}})
+	require.NoError(t, err)
+	emptyOptions, err := Fingerprint(&Ollama{BaseModel: BaseModel{Model: "llama3"}, Options: map[string]string{}})
+	require.NoError(t, err)
+	assert.Equal(t, nilOptions, emptyOptions)
+}
+
+func TestFingerprintNilModel(t *testing.T) {
+	_, err := Fingerprint(nil)
+	assert.EqualError(t, err, "cannot fingerprint a nil model")
+
+	var openai *OpenAI
+	_, err = Fingerprint(openai)
+	assert.EqualError(t, err, "cannot fingerprint a nil model")
+}
+
+func TestAnthropicSamplingRoundtrip(t *testing.T) {
+	maxTokens, topK := 2048, 40
+	temperature, topP := 0.2, 0.9
+
+	models := []Model{
+		&Anthropic{
+			BaseModel:   BaseModel{Type: ModelTypeAnthropic, Model: "claude-sonnet-4-5"},
+			MaxTokens:   &maxTokens,
+			Temperature: &temperature,
+			TopP:        &topP,
+			TopK:        &topK,
+		},
+		&GeminiAnthropic{
+			BaseModel: BaseModel{Type: ModelTypeGeminiAnthropic, Model: "claude-sonnet-4@20250514"},
+			MaxTokens: &maxTokens,
+		},
+	}
+
+	for _, model := range models {
+		t.Run(model.GetType(), func(t *testing.T) {
+			data, err := json.Marshal(model)
+			require.NoError(t, err)
+
+			parsed, err := ParseModel(data)
+			require.NoError(t, err)
+			assert.Equal(t, model, parsed)
+		})
+	}
+}
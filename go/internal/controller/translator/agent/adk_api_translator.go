@@ -709,8 +709,13 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 	// Add TLS configuration if present
 	addTLSConfiguration(modelDeploymentData, model.Spec.TLS)
 
-	switch model.Spec.Provider {
-	case v1alpha2.ModelProviderOpenAI:
+	modelType, err := adk.ModelTypeForProvider(model.Spec.Provider)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	switch modelType {
+	case adk.ModelTypeOpenAI:
 		if model.Spec.APIKeySecret != "" {
 			modelDeploymentData.EnvVars = append(modelDeploymentData.EnvVars, corev1.EnvVar{
 				Name: "OPENAI_API_KEY",
@@ -765,7 +770,7 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 			}
 		}
 		return openai, modelDeploymentData, secretHashBytes, nil
-	case v1alpha2.ModelProviderAnthropic:
+	case adk.ModelTypeAnthropic:
 		if model.Spec.APIKeySecret != "" {
 			modelDeploymentData.EnvVars = append(modelDeploymentData.EnvVars, corev1.EnvVar{
 				Name: "ANTHROPIC_API_KEY",
@@ -790,9 +795,18 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 
 		if model.Spec.Anthropic != nil {
 			anthropic.BaseUrl = model.Spec.Anthropic.BaseURL
+			anthropic.Temperature = utils.ParseStringToFloat64(model.Spec.Anthropic.Temperature)
+			anthropic.TopP = utils.ParseStringToFloat64(model.Spec.Anthropic.TopP)
+
+			if model.Spec.Anthropic.MaxTokens > 0 {
+				anthropic.MaxTokens = &model.Spec.Anthropic.MaxTokens
+			}
+			if model.Spec.Anthropic.TopK > 0 {
+				anthropic.TopK = &model.Spec.Anthropic.TopK
+			}
 		}
 		return anthropic, modelDeploymentData, secretHashBytes, nil
-	case v1alpha2.ModelProviderAzureOpenAI:
+	case adk.ModelTypeAzureOpenAI:
 		if model.Spec.AzureOpenAI == nil {
 			return nil, nil, nil, fmt.Errorf("AzureOpenAI model config is required")
 		}
@@ -835,7 +849,7 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 		populateTLSFields(&azureOpenAI.BaseModel, model.Spec.TLS)
 
 		return azureOpenAI, modelDeploymentData, secretHashBytes, nil
-	case v1alpha2.ModelProviderGeminiVertexAI:
+	case adk.ModelTypeGeminiVertexAI:
 		if model.Spec.GeminiVertexAI == nil {
 			return nil, nil, nil, fmt.Errorf("GeminiVertexAI model config is required")
 		}
@@ -879,7 +893,7 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 		populateTLSFields(&gemini.BaseModel, model.Spec.TLS)
 
 		return gemini, modelDeploymentData, secretHashBytes, nil
-	case v1alpha2.ModelProviderAnthropicVertexAI:
+	case adk.ModelTypeGeminiAnthropic:
 		if model.Spec.AnthropicVertexAI == nil {
 			return nil, nil, nil, fmt.Errorf("AnthropicVertexAI model config is required")
 		}
@@ -915,11 +929,14 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 				Headers: model.Spec.DefaultHeaders,
 			},
 		}
+		if model.Spec.AnthropicVertexAI.MaxTokens > 0 {
+			anthropic.MaxTokens = &model.Spec.AnthropicVertexAI.MaxTokens
+		}
 		// Populate TLS fields in BaseModel
 		populateTLSFields(&anthropic.BaseModel, model.Spec.TLS)
 
 		return anthropic, modelDeploymentData, secretHashBytes, nil
-	case v1alpha2.ModelProviderOllama:
+	case adk.ModelTypeOllama:
 		if model.Spec.Ollama == nil {
 			return nil, nil, nil, fmt.Errorf("ollama model config is required")
 		}
@@ -942,7 +959,7 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 		populateTLSFields(&ollama.BaseModel, model.Spec.TLS)
 
 		return ollama, modelDeploymentData, secretHashBytes, nil
-	case v1alpha2.ModelProviderGemini:
+	case adk.ModelTypeGemini:
 		modelDeploymentData.EnvVars = append(modelDeploymentData.EnvVars, corev1.EnvVar{
 			Name: "GOOGLE_API_KEY",
 			ValueFrom: &corev1.EnvVarSource{
@@ -964,7 +981,7 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 		populateTLSFields(&gemini.BaseModel, model.Spec.TLS)
 
 		return gemini, modelDeploymentData, secretHashBytes, nil
-	case v1alpha2.ModelProviderBedrock:
+	case adk.ModelTypeBedrock:
 		if model.Spec.Bedrock == nil {
 			return nil, nil, nil, fmt.Errorf("bedrock model config is required")
 		}
@@ -1048,7 +1065,7 @@ func (a *adkApiTranslator) translateModel(ctx context.Context, namespace, modelC
 		return bedrock, modelDeploymentData, secretHashBytes, nil
 	}
 
-	return nil, nil, nil, fmt.Errorf("unknown model provider: %s", model.Spec.Provider)
+	return nil, nil, nil, fmt.Errorf("unsupported model type %s for provider %s", modelType, model.Spec.Provider)
 }
 
 func (a *adkApiTranslator) translateStreamableHttpTool(ctx context.Context, server *v1alpha2.RemoteMCPServer, agentHeaders map[string]string, proxyURL string) (*adk.StreamableHTTPConnectionParams, error) {
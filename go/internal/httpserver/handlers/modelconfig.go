@@ -233,7 +233,12 @@ func (h *ModelConfigHandler) HandleCreateModelConfig(w ErrorResponseWriter, r *h
 	}
 
 	// --- ModelConfig Creation First ---
-	providerTypeEnum := v1alpha2.ModelProvider(req.Provider.Type)
+	providerTypeEnum, err := v1alpha2.NormalizeProviderType(req.Provider.Type)
+	if err != nil {
+		log.Info("Unsupported provider type", "error", err.Error())
+		w.RespondWithError(errors.NewBadRequestError(err.Error(), err))
+		return
+	}
 	modelConfigSpec := v1alpha2.ModelConfigSpec{
 		Model:    req.Model,
 		Provider: providerTypeEnum,
@@ -242,7 +247,7 @@ func (h *ModelConfigHandler) HandleCreateModelConfig(w ErrorResponseWriter, r *h
 	// Set secret references if needed, but don't create secret yet
 	if providerTypeEnum != v1alpha2.ModelProviderOllama && req.APIKey != "" {
 		secretName := modelConfigRef.Name
-		secretKey := fmt.Sprintf("%s_API_KEY", strings.ToUpper(req.Provider.Type))
+		secretKey := fmt.Sprintf("%s_API_KEY", strings.ToUpper(string(providerTypeEnum)))
 		modelConfigSpec.APIKeySecret = secretName
 		modelConfigSpec.APIKeySecretKey = secretKey
 	}
@@ -332,7 +337,7 @@ func (h *ModelConfigHandler) HandleCreateModelConfig(w ErrorResponseWriter, r *h
 	if providerTypeEnum != v1alpha2.ModelProviderOllama && req.APIKey != "" {
 		secretName := modelConfigRef.Name
 		secretNamespace := modelConfigRef.Namespace
-		secretKey := fmt.Sprintf("%s_API_KEY", strings.ToUpper(req.Provider.Type))
+		secretKey := fmt.Sprintf("%s_API_KEY", strings.ToUpper(string(providerTypeEnum)))
 
 		log.V(1).Info("Creating API key secret with OwnerReference",
 			"secretName", secretName,
@@ -420,9 +425,16 @@ func (h *ModelConfigHandler) HandleUpdateModelConfig(w ErrorResponseWriter, r *h
 		return
 	}
 
+	providerTypeEnum, err := v1alpha2.NormalizeProviderType(req.Provider.Type)
+	if err != nil {
+		log.Info("Unsupported provider type", "error", err.Error())
+		w.RespondWithError(errors.NewBadRequestError(err.Error(), err))
+		return
+	}
+
 	modelConfig.Spec = v1alpha2.ModelConfigSpec{
 		Model:             req.Model,
-		Provider:          v1alpha2.ModelProvider(req.Provider.Type),
+		Provider:          providerTypeEnum,
 		APIKeySecret:      modelConfig.Spec.APIKeySecret,
 		APIKeySecretKey:   modelConfig.Spec.APIKeySecretKey,
 		OpenAI:            nil,
@@ -170,6 +170,35 @@ func TestModelConfigHandler(t *testing.T) {
 			assert.Equal(t, v1alpha2.ModelProviderAnthropic, config.Data.Spec.Provider)
 		})
 
+		t.Run("Success_CaseInsensitiveProvider", func(t *testing.T) {
+			handler, _, responseRecorder := setupHandler()
+
+			reqBody := api.CreateModelConfigRequest{
+				Ref:      "default/test-lowercase",
+				Provider: api.Provider{Type: "openai"},
+				Model:    "gpt-4",
+				APIKey:   "test-api-key",
+				OpenAIParams: &v1alpha2.OpenAIConfig{
+					BaseURL: "https://api.openai.com/v1",
+				},
+			}
+
+			jsonBody, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest("POST", "/api/modelconfigs/", bytes.NewBuffer(jsonBody))
+			req = setUser(req, "test-user")
+			req.Header.Set("Content-Type", "application/json")
+
+			handler.HandleCreateModelConfig(responseRecorder, req)
+
+			assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+			var config api.StandardResponse[v1alpha2.ModelConfig]
+			err := json.Unmarshal(responseRecorder.Body.Bytes(), &config)
+			require.NoError(t, err)
+			assert.Equal(t, v1alpha2.ModelProviderOpenAI, config.Data.Spec.Provider)
+			assert.Equal(t, "OPENAI_API_KEY", config.Data.Spec.APIKeySecretKey)
+		})
+
 		t.Run("Success_Ollama_NoAPIKey", func(t *testing.T) {
 			handler, _, responseRecorder := setupHandler()
 
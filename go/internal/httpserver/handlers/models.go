@@ -1,14 +1,89 @@
 package handlers
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"reflect"
+	"slices"
+	"strings"
 
 	v1alpha2 "github.com/kagent-dev/kagent/go/api/v1alpha2"
+	"github.com/kagent-dev/kagent/go/internal/adk"
+	"github.com/kagent-dev/kagent/go/internal/httpserver/errors"
 	kclient "github.com/kagent-dev/kagent/go/pkg/client"
 	"github.com/kagent-dev/kagent/go/pkg/client/api"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// supportedModels maps provider names to their supported models.
+// The keys need to match what the UI expects (camelCase for API keys)
+var supportedModels = kclient.ProviderModels{
+	v1alpha2.ModelProviderOpenAI: {
+		{Name: "gpt-5", FunctionCalling: true},
+		{Name: "gpt-5-mini", FunctionCalling: true},
+		{Name: "gpt-5-nano", FunctionCalling: true},
+		{Name: "gpt-4o", FunctionCalling: true},
+		{Name: "o4-mini", FunctionCalling: true},
+		{Name: "gpt-4-turbo", FunctionCalling: true},
+		{Name: "gpt-4", FunctionCalling: true},
+		{Name: "gpt-3.5-turbo", FunctionCalling: true},
+	},
+	v1alpha2.ModelProviderAnthropic: {
+		{Name: "claude-opus-4-1-20250805", FunctionCalling: true},
+		{Name: "claude-opus-4-20250514", FunctionCalling: true},
+		{Name: "claude-sonnet-4-20250514", FunctionCalling: true},
+		{Name: "claude-3-7-sonnet-20250219", FunctionCalling: true},
+		{Name: "claude-3-5-sonnet-20240620", FunctionCalling: true},
+		{Name: "claude-sonnet-4-5", FunctionCalling: true},
+	},
+	v1alpha2.ModelProviderAzureOpenAI: {
+		{Name: "gpt-4", FunctionCalling: true},
+		{Name: "gpt-35-turbo", FunctionCalling: true},
+		{Name: "gpt-oss-120b", FunctionCalling: true},
+		{Name: "gpt-4.1", FunctionCalling: true},
+		{Name: "gpt-4.1-mini", FunctionCalling: true},
+		{Name: "gpt-4.1-nano", FunctionCalling: true},
+		{Name: "gpt-4o", FunctionCalling: true},
+		{Name: "gpt-4o-mini", FunctionCalling: true}, {Name: "o4-mini", FunctionCalling: true},
+		{Name: "o3", FunctionCalling: true},
+		{Name: "o3-mini", FunctionCalling: true},
+	},
+	v1alpha2.ModelProviderOllama: {
+		{Name: "llama2", FunctionCalling: false},
+		{Name: "llama2:13b", FunctionCalling: false},
+		{Name: "llama2:70b", FunctionCalling: false},
+		{Name: "mistral", FunctionCalling: false},
+		{Name: "mixtral", FunctionCalling: false},
+	},
+	v1alpha2.ModelProviderGemini: {
+		{Name: "gemini-2.5-pro", FunctionCalling: true},
+		{Name: "gemini-2.5-flash", FunctionCalling: true},
+		{Name: "gemini-2.5-flash-lite", FunctionCalling: true},
+		{Name: "gemini-2.0-flash", FunctionCalling: true},
+		{Name: "gemini-2.0-flash-lite", FunctionCalling: true},
+	},
+	v1alpha2.ModelProviderGeminiVertexAI: {
+		{Name: "gemini-2.5-pro", FunctionCalling: true},
+		{Name: "gemini-2.5-flash", FunctionCalling: true},
+		{Name: "gemini-2.5-flash-lite", FunctionCalling: true},
+		{Name: "gemini-2.0-flash", FunctionCalling: true},
+		{Name: "gemini-2.0-flash-lite", FunctionCalling: true},
+	},
+	v1alpha2.ModelProviderAnthropicVertexAI: {
+		{Name: "claude-opus-4-1@20250805", FunctionCalling: true},
+		{Name: "claude-sonnet-4@20250514", FunctionCalling: true},
+		{Name: "claude-3-5-haiku@20241022", FunctionCalling: true},
+	},
+	v1alpha2.ModelProviderBedrock: {
+		{Name: "anthropic.claude-3-sonnet-20240229-v1:0", FunctionCalling: true},
+		{Name: "us.anthropic.claude-3-5-haiku-20241022-v1:0", FunctionCalling: true},
+		{Name: "global.anthropic.claude-sonnet-4-5-20250929-v1:0", FunctionCalling: true},
+		{Name: "global.anthropic.claude-opus-4-5-20251101-v1:0", FunctionCalling: true},
+		{Name: "us.amazon.nova-2-lite-v1:0", FunctionCalling: false},
+	},
+}
+
 // ModelHandler handles model requests
 type ModelHandler struct {
 	*Base
@@ -24,75 +99,241 @@ func (h *ModelHandler) HandleListSupportedModels(w ErrorResponseWriter, r *http.
 
 	log.Info("Listing supported models")
 
-	// Create a map of provider names to their supported models
-	// The keys need to match what the UI expects (camelCase for API keys)
-	supportedModels := kclient.ProviderModels{
-		v1alpha2.ModelProviderOpenAI: {
-			{Name: "gpt-5", FunctionCalling: true},
-			{Name: "gpt-5-mini", FunctionCalling: true},
-			{Name: "gpt-5-nano", FunctionCalling: true},
-			{Name: "gpt-4o", FunctionCalling: true},
-			{Name: "o4-mini", FunctionCalling: true},
-			{Name: "gpt-4-turbo", FunctionCalling: true},
-			{Name: "gpt-4", FunctionCalling: true},
-			{Name: "gpt-3.5-turbo", FunctionCalling: true},
-		},
-		v1alpha2.ModelProviderAnthropic: {
-			{Name: "claude-opus-4-1-20250805", FunctionCalling: true},
-			{Name: "claude-opus-4-20250514", FunctionCalling: true},
-			{Name: "claude-sonnet-4-20250514", FunctionCalling: true},
-			{Name: "claude-3-7-sonnet-20250219", FunctionCalling: true},
-			{Name: "claude-3-5-sonnet-20240620", FunctionCalling: true},
-			{Name: "claude-sonnet-4-5", FunctionCalling: true},
-		},
-		v1alpha2.ModelProviderAzureOpenAI: {
-			{Name: "gpt-4", FunctionCalling: true},
-			{Name: "gpt-35-turbo", FunctionCalling: true},
-			{Name: "gpt-oss-120b", FunctionCalling: true},
-			{Name: "gpt-4.1", FunctionCalling: true},
-			{Name: "gpt-4.1-mini", FunctionCalling: true},
-			{Name: "gpt-4.1-nano", FunctionCalling: true},
-			{Name: "gpt-4o", FunctionCalling: true},
-			{Name: "gpt-4o-mini", FunctionCalling: true}, {Name: "o4-mini", FunctionCalling: true},
-			{Name: "o3", FunctionCalling: true},
-			{Name: "o3-mini", FunctionCalling: true},
-		},
-		v1alpha2.ModelProviderOllama: {
-			{Name: "llama2", FunctionCalling: false},
-			{Name: "llama2:13b", FunctionCalling: false},
-			{Name: "llama2:70b", FunctionCalling: false},
-			{Name: "mistral", FunctionCalling: false},
-			{Name: "mixtral", FunctionCalling: false},
-		},
-		v1alpha2.ModelProviderGemini: {
-			{Name: "gemini-2.5-pro", FunctionCalling: true},
-			{Name: "gemini-2.5-flash", FunctionCalling: true},
-			{Name: "gemini-2.5-flash-lite", FunctionCalling: true},
-			{Name: "gemini-2.0-flash", FunctionCalling: true},
-			{Name: "gemini-2.0-flash-lite", FunctionCalling: true},
-		},
-		v1alpha2.ModelProviderGeminiVertexAI: {
-			{Name: "gemini-2.5-pro", FunctionCalling: true},
-			{Name: "gemini-2.5-flash", FunctionCalling: true},
-			{Name: "gemini-2.5-flash-lite", FunctionCalling: true},
-			{Name: "gemini-2.0-flash", FunctionCalling: true},
-			{Name: "gemini-2.0-flash-lite", FunctionCalling: true},
-		},
-		v1alpha2.ModelProviderAnthropicVertexAI: {
-			{Name: "claude-opus-4-1@20250805", FunctionCalling: true},
-			{Name: "claude-sonnet-4@20250514", FunctionCalling: true},
-			{Name: "claude-3-5-haiku@20241022", FunctionCalling: true},
-		},
-		v1alpha2.ModelProviderBedrock: {
-			{Name: "anthropic.claude-3-sonnet-20240229-v1:0", FunctionCalling: true},
-			{Name: "us.anthropic.claude-3-5-haiku-20241022-v1:0", FunctionCalling: true},
-			{Name: "global.anthropic.claude-sonnet-4-5-20250929-v1:0", FunctionCalling: true},
-			{Name: "global.anthropic.claude-opus-4-5-20251101-v1:0", FunctionCalling: true},
-			{Name: "us.amazon.nova-2-lite-v1:0", FunctionCalling: false},
-		},
-	}
-
 	log.Info("Successfully listed supported models", "count", len(supportedModels))
 	data := api.NewResponse(supportedModels, "Successfully listed supported models", false)
 	RespondWithJSON(w, http.StatusOK, data)
 }
+
+// adkModelTypes maps ADK model type discriminators to the structs agents parse
+// their model config into.
+var adkModelTypes = map[string]reflect.Type{
+	adk.ModelTypeOpenAI:          reflect.TypeFor[adk.OpenAI](),
+	adk.ModelTypeAzureOpenAI:     reflect.TypeFor[adk.AzureOpenAI](),
+	adk.ModelTypeAnthropic:       reflect.TypeFor[adk.Anthropic](),
+	adk.ModelTypeGeminiVertexAI:  reflect.TypeFor[adk.GeminiVertexAI](),
+	adk.ModelTypeGeminiAnthropic: reflect.TypeFor[adk.GeminiAnthropic](),
+	adk.ModelTypeOllama:          reflect.TypeFor[adk.Ollama](),
+	adk.ModelTypeGemini:          reflect.TypeFor[adk.Gemini](),
+	adk.ModelTypeBedrock:         reflect.TypeFor[adk.Bedrock](),
+}
+
+// HandleGetModelSchema handles GET /api/models/schema/{type} requests
+func (h *ModelHandler) HandleGetModelSchema(w ErrorResponseWriter, r *http.Request) {
+	log := ctrllog.FromContext(r.Context()).WithName("model-handler").WithValues("operation", "get-model-schema")
+
+	modelType, err := GetPathParam(r, "type")
+	if err != nil {
+		w.RespondWithError(errors.NewBadRequestError("Failed to get model type from path", err))
+		return
+	}
+	log = log.WithValues("modelType", modelType)
+
+	structType, ok := adkModelTypes[modelType]
+	if !ok {
+		log.Info("Unknown model type")
+		w.RespondWithError(errors.NewNotFoundError(fmt.Sprintf("Unknown model type: %s", modelType), nil))
+		return
+	}
+
+	schema := jsonSchemaForType(structType)
+	if err := removeUnserializedFields(schema, structType); err != nil {
+		log.Error(err, "Failed to determine serialized model fields")
+		w.RespondWithError(errors.NewInternalServerError("Failed to generate model schema", err))
+		return
+	}
+	if err := allowSerializedNulls(schema, structType); err != nil {
+		log.Error(err, "Failed to determine nullable model fields")
+		w.RespondWithError(errors.NewInternalServerError("Failed to generate model schema", err))
+		return
+	}
+	// The type field is a discriminator, so only one value is valid per schema
+	schema["properties"].(map[string]any)["type"] = map[string]any{"type": "string", "const": modelType}
+
+	log.Info("Successfully generated model schema")
+	data := api.NewResponse(schema, "Successfully generated model schema", false)
+	RespondWithJSON(w, http.StatusOK, data)
+}
+
+// customTypeSchemas are the schemas of types whose MarshalJSON does not
+// follow their json tags.
+var customTypeSchemas = map[reflect.Type]map[string]any{
+	// auto, none and required are bare strings; only a forced function is an object
+	reflect.TypeFor[adk.OpenAIToolChoice](): {
+		"oneOf": []any{
+			map[string]any{
+				"type": "string",
+				"enum": []string{adk.ToolChoiceAuto, adk.ToolChoiceNone, adk.ToolChoiceRequired},
+			},
+			map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"type": map[string]any{"type": "string", "const": adk.ToolChoiceFunction},
+					"function": map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"name": map[string]any{"type": "string"}},
+						"required":   []string{"name"},
+					},
+				},
+				"required": []string{"type", "function"},
+			},
+		},
+	},
+}
+
+// jsonSchemaForType builds a JSON schema from a type's json tags. Pointer
+// fields and fields tagged omitempty are optional; all others are required.
+func jsonSchemaForType(t reflect.Type) map[string]any {
+	if schema, ok := customTypeSchemas[t]; ok {
+		return schema
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return jsonSchemaForType(t.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": jsonSchemaForType(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": jsonSchemaForType(t.Elem())}
+	case reflect.Struct:
+		properties := map[string]any{}
+		required := []string{}
+		addStructFields(t, properties, &required)
+		schema := map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	default:
+		// interface{} and anything else accepts any JSON value
+		return map[string]any{}
+	}
+}
+
+func addStructFields(t reflect.Type, properties map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		// Embedded structs like adk.BaseModel are flattened into the parent
+		if field.Anonymous && jsonTag == "" {
+			addStructFields(field.Type, properties, required)
+			continue
+		}
+		if !field.IsExported() || jsonTag == "" {
+			continue
+		}
+
+		tagParts := strings.Split(jsonTag, ",")
+		name := tagParts[0]
+		properties[name] = jsonSchemaForType(field.Type)
+
+		optional := field.Type.Kind() == reflect.Pointer
+		for _, opt := range tagParts[1:] {
+			if opt == "omitempty" {
+				optional = true
+			}
+		}
+		if !optional {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// removeUnserializedFields drops the properties a model's MarshalJSON never
+// writes. Most model types marshal through a hand-written map, so their json
+// tags list fields (e.g. the TLS settings) that are not part of the output.
+// Which keys are written is found by marshalling a value of t with every
+// field set.
+func removeUnserializedFields(schema map[string]any, t reflect.Type) error {
+	sample := reflect.New(t)
+	fillSample(sample.Elem())
+	data, err := json.Marshal(sample.Interface())
+	if err != nil {
+		return err
+	}
+	var serialized map[string]json.RawMessage
+	if err := json.Unmarshal(data, &serialized); err != nil {
+		return err
+	}
+
+	properties := schema["properties"].(map[string]any)
+	for name := range properties {
+		if _, ok := serialized[name]; !ok {
+			delete(properties, name)
+		}
+	}
+	if required, ok := schema["required"].([]string); ok {
+		schema["required"] = slices.DeleteFunc(required, func(name string) bool {
+			_, ok := serialized[name]
+			return !ok
+		})
+	}
+	return nil
+}
+
+// allowSerializedNulls lets properties be null where a zero value of t is
+// marshalled as null, e.g. the headers map written by the map-based model
+// marshallers even when no headers are set.
+func allowSerializedNulls(schema map[string]any, t reflect.Type) error {
+	data, err := json.Marshal(reflect.New(t).Interface())
+	if err != nil {
+		return err
+	}
+	var serialized map[string]json.RawMessage
+	if err := json.Unmarshal(data, &serialized); err != nil {
+		return err
+	}
+
+	properties := schema["properties"].(map[string]any)
+	for name, value := range serialized {
+		property, ok := properties[name].(map[string]any)
+		if !ok || string(value) != "null" {
+			continue
+		}
+		if typ, ok := property["type"].(string); ok {
+			property["type"] = []string{typ, "null"}
+		}
+	}
+	return nil
+}
+
+// fillSample sets v to a non-empty value so that omitempty fields and fields
+// only written when set show up in the marshalled output.
+func fillSample(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		m.SetMapIndex(reflect.New(v.Type().Key()).Elem(), reflect.New(v.Type().Elem()).Elem())
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fillSample(v.Field(i))
+			}
+		}
+	}
+}
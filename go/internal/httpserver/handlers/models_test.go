@@ -0,0 +1,137 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kagent-dev/kagent/go/internal/httpserver/handlers"
+	"github.com/kagent-dev/kagent/go/pkg/client/api"
+)
+
+func TestModelHandler(t *testing.T) {
+	setupHandler := func() (*handlers.ModelHandler, *mockErrorResponseWriter) {
+		handler := handlers.NewModelHandler(&handlers.Base{})
+		responseRecorder := newMockErrorResponseWriter()
+		return handler, responseRecorder
+	}
+
+	t.Run("HandleGetModelSchema", func(t *testing.T) {
+		t.Run("Success_OpenAI", func(t *testing.T) {
+			handler, responseRecorder := setupHandler()
+
+			req := httptest.NewRequest("GET", "/api/models/schema/openai", nil)
+			req = mux.SetURLVars(req, map[string]string{"type": "openai"})
+			handler.HandleGetModelSchema(responseRecorder, req)
+
+			require.Equal(t, http.StatusOK, responseRecorder.Code)
+
+			var response api.StandardResponse[map[string]any]
+			err := json.Unmarshal(responseRecorder.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			schema := response.Data
+			assert.Equal(t, "object", schema["type"])
+			properties := schema["properties"].(map[string]any)
+
+			assert.Equal(t, map[string]any{"type": "string", "const": "openai"}, properties["type"])
+			assert.Equal(t, map[string]any{"type": "number"}, properties["temperature"])
+			assert.Equal(t, map[string]any{"type": "string"}, properties["reasoning_effort"])
+			assert.Equal(t, map[string]any{"type": "integer"}, properties["max_tokens"])
+			// Fields from the embedded BaseModel are flattened
+			assert.Contains(t, properties, "model")
+			assert.Contains(t, properties, "timeout")
+			// OpenAI omits empty headers instead of writing null
+			assert.Equal(t, "object", properties["headers"].(map[string]any)["type"])
+
+			required := schema["required"].([]any)
+			assert.Contains(t, required, "model")
+			assert.Contains(t, required, "base_url")
+			assert.NotContains(t, required, "temperature")
+			assert.NotContains(t, required, "reasoning_effort")
+
+			// OpenAI marshals every tagged field, including the TLS settings
+			assert.Contains(t, properties, "tls_disable_verify")
+			assert.Contains(t, properties, "tls_ca_cert_path")
+
+			// tool_choice is a bare string unless a function is forced
+			toolChoice := properties["tool_choice"].(map[string]any)
+			oneOf := toolChoice["oneOf"].([]any)
+			require.Len(t, oneOf, 2)
+			assert.Equal(t, map[string]any{"type": "string", "enum": []any{"auto", "none", "required"}}, oneOf[0])
+			function := oneOf[1].(map[string]any)
+			assert.Equal(t, "object", function["type"])
+			assert.Equal(t, []any{"type", "function"}, function["required"])
+		})
+
+		t.Run("Success_Anthropic", func(t *testing.T) {
+			handler, responseRecorder := setupHandler()
+
+			req := httptest.NewRequest("GET", "/api/models/schema/anthropic", nil)
+			req = mux.SetURLVars(req, map[string]string{"type": "anthropic"})
+			handler.HandleGetModelSchema(responseRecorder, req)
+
+			require.Equal(t, http.StatusOK, responseRecorder.Code)
+
+			var response api.StandardResponse[map[string]any]
+			err := json.Unmarshal(responseRecorder.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			properties := response.Data["properties"].(map[string]any)
+			assert.Contains(t, properties, "base_url")
+			assert.Contains(t, properties, "max_tokens")
+			assert.Contains(t, properties, "timeout")
+
+			// headers is always written, and is null when unset
+			assert.Equal(t, []any{"object", "null"}, properties["headers"].(map[string]any)["type"])
+
+			// Anthropic takes the same tool_choice shape as OpenAI through LiteLLM
+			toolChoice := properties["tool_choice"].(map[string]any)
+			assert.Len(t, toolChoice["oneOf"], 2)
+		})
+
+		t.Run("OnlySerializedFields", func(t *testing.T) {
+			// These model types marshal through a hand-written map that does
+			// not include the TLS settings
+			for _, modelType := range []string{"anthropic", "azure_openai", "gemini", "gemini_vertex_ai", "gemini_anthropic", "ollama", "bedrock"} {
+				t.Run(modelType, func(t *testing.T) {
+					handler, responseRecorder := setupHandler()
+
+					req := httptest.NewRequest("GET", "/api/models/schema/"+modelType, nil)
+					req = mux.SetURLVars(req, map[string]string{"type": modelType})
+					handler.HandleGetModelSchema(responseRecorder, req)
+
+					require.Equal(t, http.StatusOK, responseRecorder.Code)
+
+					var response api.StandardResponse[map[string]any]
+					err := json.Unmarshal(responseRecorder.Body.Bytes(), &response)
+					require.NoError(t, err)
+
+					properties := response.Data["properties"].(map[string]any)
+					assert.Contains(t, properties, "model")
+					assert.NotContains(t, properties, "tls_disable_verify")
+					assert.NotContains(t, properties, "tls_ca_cert_path")
+					assert.NotContains(t, properties, "tls_disable_system_cas")
+					// The map-based marshallers write null headers when none are set
+					assert.Equal(t, []any{"object", "null"}, properties["headers"].(map[string]any)["type"])
+				})
+			}
+		})
+
+		t.Run("UnknownType", func(t *testing.T) {
+			handler, responseRecorder := setupHandler()
+
+			req := httptest.NewRequest("GET", "/api/models/schema/unknown", nil)
+			req = mux.SetURLVars(req, map[string]string{"type": "unknown"})
+			handler.HandleGetModelSchema(responseRecorder, req)
+
+			assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+			assert.NotNil(t, responseRecorder.errorReceived)
+		})
+	})
+}
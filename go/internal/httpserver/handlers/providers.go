@@ -1,11 +1,15 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/kagent-dev/kagent/go/api/v1alpha1"
 	"github.com/kagent-dev/kagent/go/api/v1alpha2"
+	"github.com/kagent-dev/kagent/go/internal/httpserver/errors"
 	"github.com/kagent-dev/kagent/go/pkg/client/api"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -20,6 +24,81 @@ func NewProviderHandler(base *Base) *ProviderHandler {
 	return &ProviderHandler{Base: base}
 }
 
+// Capabilities a model provider can support
+const (
+	CapabilityToolCalling = "tool-calling"
+	CapabilityEmbeddings  = "embeddings"
+	CapabilityVision      = "vision"
+)
+
+var supportedCapabilities = []string{CapabilityToolCalling, CapabilityEmbeddings, CapabilityVision}
+
+// modelProviderCapabilities lists what each provider's API supports. Individual
+// models served by a provider may support less.
+var modelProviderCapabilities = map[v1alpha2.ModelProvider][]string{
+	v1alpha2.ModelProviderOpenAI:            {CapabilityToolCalling, CapabilityEmbeddings, CapabilityVision},
+	v1alpha2.ModelProviderAnthropic:         {CapabilityToolCalling, CapabilityVision},
+	v1alpha2.ModelProviderAzureOpenAI:       {CapabilityToolCalling, CapabilityEmbeddings, CapabilityVision},
+	v1alpha2.ModelProviderOllama:            {CapabilityToolCalling, CapabilityEmbeddings, CapabilityVision},
+	v1alpha2.ModelProviderGemini:            {CapabilityToolCalling, CapabilityEmbeddings, CapabilityVision},
+	v1alpha2.ModelProviderGeminiVertexAI:    {CapabilityToolCalling, CapabilityEmbeddings, CapabilityVision},
+	v1alpha2.ModelProviderAnthropicVertexAI: {CapabilityToolCalling, CapabilityVision},
+	v1alpha2.ModelProviderBedrock:           {CapabilityToolCalling, CapabilityEmbeddings, CapabilityVision},
+}
+
+// How a provider's credentials are supplied to the agent
+const (
+	AuthStyleNone           = "none"
+	AuthStyleAPIKey         = "api-key"
+	AuthStyleServiceAccount = "service-account"
+	AuthStyleAWS            = "aws"
+)
+
+// modelProviderAuthStyles mirrors how the agent translator passes the
+// apiKeySecret to each provider.
+var modelProviderAuthStyles = map[v1alpha2.ModelProvider]string{
+	v1alpha2.ModelProviderOpenAI:            AuthStyleAPIKey,
+	v1alpha2.ModelProviderAnthropic:         AuthStyleAPIKey,
+	v1alpha2.ModelProviderAzureOpenAI:       AuthStyleAPIKey,
+	v1alpha2.ModelProviderOllama:            AuthStyleNone,
+	v1alpha2.ModelProviderGemini:            AuthStyleAPIKey,
+	v1alpha2.ModelProviderGeminiVertexAI:    AuthStyleServiceAccount,
+	v1alpha2.ModelProviderAnthropicVertexAI: AuthStyleServiceAccount,
+	v1alpha2.ModelProviderBedrock:           AuthStyleAWS,
+}
+
+// modelProviderRequiresSecret reports whether the agent translator always
+// references apiKeySecret for the provider. The others either need no
+// credentials or can fall back to ambient credentials such as workload identity.
+func modelProviderRequiresSecret(providerType v1alpha2.ModelProvider) bool {
+	switch providerType {
+	case v1alpha2.ModelProviderAzureOpenAI, v1alpha2.ModelProviderGemini:
+		return true
+	default:
+		return false
+	}
+}
+
+// knownModelProviders are providers whose models are hard to list live, so the
+// supported providers response suggests model IDs from supportedModels. The
+// suggestions are curated, not a statement of what a given account can use.
+var knownModelProviders = []v1alpha2.ModelProvider{
+	v1alpha2.ModelProviderGeminiVertexAI,
+	v1alpha2.ModelProviderAnthropicVertexAI,
+	v1alpha2.ModelProviderBedrock,
+}
+
+func getKnownModelsForModelProvider(providerType v1alpha2.ModelProvider) []string {
+	if !slices.Contains(knownModelProviders, providerType) {
+		return nil
+	}
+	knownModels := []string{}
+	for _, model := range supportedModels[providerType] {
+		knownModels = append(knownModels, model.Name)
+	}
+	return knownModels
+}
+
 // Helper function to get JSON keys specifically marked as required
 func getRequiredKeysForModelProvider(providerType v1alpha2.ModelProvider) []string {
 	switch providerType {
@@ -91,7 +170,14 @@ func (h *ProviderHandler) HandleListSupportedMemoryProviders(w ErrorResponseWrit
 func (h *ProviderHandler) HandleListSupportedModelProviders(w ErrorResponseWriter, r *http.Request) {
 	log := ctrllog.FromContext(r.Context()).WithName("provider-handler").WithValues("operation", "list-supported-model-providers")
 
-	log.Info("Listing supported model providers with parameters")
+	capability := r.URL.Query().Get("capability")
+	if capability != "" && !slices.Contains(supportedCapabilities, capability) {
+		err := fmt.Errorf("invalid capability %q: must be one of %s", capability, strings.Join(supportedCapabilities, ", "))
+		w.RespondWithError(errors.NewBadRequestError(err.Error(), err))
+		return
+	}
+
+	log.Info("Listing supported model providers with parameters", "capability", capability)
 
 	providersData := []struct {
 		providerEnum v1alpha2.ModelProvider
@@ -110,6 +196,11 @@ func (h *ProviderHandler) HandleListSupportedModelProviders(w ErrorResponseWrite
 	providersResponse := []map[string]any{}
 
 	for _, pData := range providersData {
+		capabilities := modelProviderCapabilities[pData.providerEnum]
+		if capability != "" && !slices.Contains(capabilities, capability) {
+			continue
+		}
+
 		allKeys := getStructJSONKeys(pData.configType)
 		requiredKeys := getRequiredKeysForModelProvider(pData.providerEnum)
 		requiredSet := make(map[string]struct{})
@@ -124,12 +215,20 @@ func (h *ProviderHandler) HandleListSupportedModelProviders(w ErrorResponseWrite
 			}
 		}
 
-		providersResponse = append(providersResponse, map[string]any{
+		providerResponse := map[string]any{
 			"name":           string(pData.providerEnum),
 			"type":           string(pData.providerEnum),
 			"requiredParams": requiredKeys,
 			"optionalParams": optionalKeys,
-		})
+			"capabilities":   capabilities,
+			"requiresSecret": modelProviderRequiresSecret(pData.providerEnum),
+			"authStyle":      modelProviderAuthStyles[pData.providerEnum],
+		}
+		if knownModels := getKnownModelsForModelProvider(pData.providerEnum); len(knownModels) > 0 {
+			providerResponse["knownModels"] = knownModels
+		}
+
+		providersResponse = append(providersResponse, providerResponse)
 	}
 
 	data := api.NewResponse(providersResponse, "Successfully listed supported model providers", false)
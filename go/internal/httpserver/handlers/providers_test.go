@@ -0,0 +1,140 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kagent-dev/kagent/go/api/v1alpha2"
+	"github.com/kagent-dev/kagent/go/internal/httpserver/handlers"
+	"github.com/kagent-dev/kagent/go/pkg/client/api"
+)
+
+func TestProviderHandler(t *testing.T) {
+	setupHandler := func() (*handlers.ProviderHandler, *mockErrorResponseWriter) {
+		handler := handlers.NewProviderHandler(&handlers.Base{})
+		responseRecorder := newMockErrorResponseWriter()
+		return handler, responseRecorder
+	}
+
+	listProviders := func(t *testing.T, query string) []api.ProviderInfo {
+		handler, responseRecorder := setupHandler()
+
+		req := httptest.NewRequest("GET", "/api/providers/models"+query, nil)
+		handler.HandleListSupportedModelProviders(responseRecorder, req)
+
+		require.Equal(t, http.StatusOK, responseRecorder.Code)
+		require.Nil(t, responseRecorder.errorReceived)
+
+		var response api.StandardResponse[[]api.ProviderInfo]
+		err := json.Unmarshal(responseRecorder.Body.Bytes(), &response)
+		require.NoError(t, err)
+		return response.Data
+	}
+
+	providerTypes := func(providers []api.ProviderInfo) []string {
+		types := make([]string, 0, len(providers))
+		for _, p := range providers {
+			types = append(types, p.Type)
+		}
+		return types
+	}
+
+	t.Run("HandleListSupportedModelProviders", func(t *testing.T) {
+		t.Run("Success_All", func(t *testing.T) {
+			providers := listProviders(t, "")
+			assert.Len(t, providers, len(v1alpha2.ModelProviders))
+		})
+
+		t.Run("Success_FilterEmbeddings", func(t *testing.T) {
+			types := providerTypes(listProviders(t, "?capability=embeddings"))
+			assert.Contains(t, types, string(v1alpha2.ModelProviderOpenAI))
+			assert.Contains(t, types, string(v1alpha2.ModelProviderOllama))
+			assert.NotContains(t, types, string(v1alpha2.ModelProviderAnthropic))
+			assert.NotContains(t, types, string(v1alpha2.ModelProviderAnthropicVertexAI))
+		})
+
+		t.Run("Success_FilterToolCalling", func(t *testing.T) {
+			providers := listProviders(t, "?capability=tool-calling")
+			assert.Len(t, providers, len(v1alpha2.ModelProviders))
+		})
+
+		t.Run("Success_KnownModels", func(t *testing.T) {
+			handler, responseRecorder := setupHandler()
+
+			req := httptest.NewRequest("GET", "/api/providers/models", nil)
+			handler.HandleListSupportedModelProviders(responseRecorder, req)
+			require.Equal(t, http.StatusOK, responseRecorder.Code)
+
+			var response api.StandardResponse[[]map[string]any]
+			err := json.Unmarshal(responseRecorder.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			providers := map[string]map[string]any{}
+			for _, p := range response.Data {
+				providers[p["type"].(string)] = p
+			}
+
+			bedrock := providers[string(v1alpha2.ModelProviderBedrock)]
+			require.NotNil(t, bedrock)
+			knownModels, ok := bedrock["knownModels"].([]any)
+			require.True(t, ok, "Bedrock should return knownModels")
+			assert.NotEmpty(t, knownModels)
+			assert.Contains(t, knownModels, "global.anthropic.claude-sonnet-4-5-20250929-v1:0")
+
+			openai := providers[string(v1alpha2.ModelProviderOpenAI)]
+			require.NotNil(t, openai)
+			assert.NotContains(t, openai, "knownModels")
+		})
+
+		t.Run("Success_AuthMetadata", func(t *testing.T) {
+			handler, responseRecorder := setupHandler()
+
+			req := httptest.NewRequest("GET", "/api/providers/models", nil)
+			handler.HandleListSupportedModelProviders(responseRecorder, req)
+			require.Equal(t, http.StatusOK, responseRecorder.Code)
+
+			var response api.StandardResponse[[]map[string]any]
+			err := json.Unmarshal(responseRecorder.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			expected := map[string]struct {
+				requiresSecret bool
+				authStyle      string
+			}{
+				string(v1alpha2.ModelProviderOpenAI):            {false, handlers.AuthStyleAPIKey},
+				string(v1alpha2.ModelProviderAnthropic):         {false, handlers.AuthStyleAPIKey},
+				string(v1alpha2.ModelProviderAzureOpenAI):       {true, handlers.AuthStyleAPIKey},
+				string(v1alpha2.ModelProviderOllama):            {false, handlers.AuthStyleNone},
+				string(v1alpha2.ModelProviderGemini):            {true, handlers.AuthStyleAPIKey},
+				string(v1alpha2.ModelProviderGeminiVertexAI):    {false, handlers.AuthStyleServiceAccount},
+				string(v1alpha2.ModelProviderAnthropicVertexAI): {false, handlers.AuthStyleServiceAccount},
+				string(v1alpha2.ModelProviderBedrock):           {false, handlers.AuthStyleAWS},
+			}
+
+			require.Len(t, response.Data, len(expected))
+			for _, p := range response.Data {
+				providerType := p["type"].(string)
+				want, ok := expected[providerType]
+				require.True(t, ok, "unexpected provider %s", providerType)
+				assert.Equal(t, want.requiresSecret, p["requiresSecret"], providerType)
+				assert.Equal(t, want.authStyle, p["authStyle"], providerType)
+			}
+		})
+
+		t.Run("InvalidCapability", func(t *testing.T) {
+			handler, responseRecorder := setupHandler()
+
+			req := httptest.NewRequest("GET", "/api/providers/models?capability=telepathy", nil)
+			handler.HandleListSupportedModelProviders(responseRecorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			require.NotNil(t, responseRecorder.errorReceived)
+			assert.Contains(t, responseRecorder.errorReceived.Error(), "tool-calling, embeddings, vision")
+		})
+	})
+}
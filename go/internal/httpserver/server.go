@@ -197,6 +197,7 @@ func (s *HTTPServer) setupRoutes() {
 
 	// Models
 	s.router.HandleFunc(APIPathModels, adaptHandler(s.handlers.Model.HandleListSupportedModels)).Methods(http.MethodGet)
+	s.router.HandleFunc(APIPathModels+"/schema/{type}", adaptHandler(s.handlers.Model.HandleGetModelSchema)).Methods(http.MethodGet)
 
 	// Memories
 	s.router.HandleFunc(APIPathMemories, adaptHandler(s.handlers.Memory.HandleListMemories)).Methods(http.MethodGet)
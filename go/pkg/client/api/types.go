@@ -181,6 +181,12 @@ type ProviderInfo struct {
 	Type           string   `json:"type"`
 	RequiredParams []string `json:"requiredParams"`
 	OptionalParams []string `json:"optionalParams"`
+	// The fields below are only returned for model providers
+	RequiresSecret bool     `json:"requiresSecret,omitempty"`
+	AuthStyle      string   `json:"authStyle,omitempty"`
+	Capabilities   []string `json:"capabilities,omitempty"`
+	// KnownModels are curated model ID suggestions, not live availability
+	KnownModels []string `json:"knownModels,omitempty"`
 }
 
 // SessionRunsResponse represents the response for session runs
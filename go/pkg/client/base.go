@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -24,6 +26,54 @@ func (e *ClientError) Error() string {
 	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
 }
 
+// Is lets errors.Is match a ClientError against ErrNotFound and ErrUnauthorized
+func (e *ClientError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+var (
+	// ErrNotFound is matched by errors for 404 responses
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is matched by errors for 401 and 403 responses
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// TransportError is returned when the request did not get an HTTP response,
+// e.g. because the server could not be reached
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("request failed: %v", e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound reports whether err is a 404 response from the server
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err is a 401 or 403 response from the server
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsTransportError reports whether err happened before a response was received
+func IsTransportError(err error) bool {
+	var transportErr *TransportError
+	return errors.As(err, &transportErr)
+}
+
 // ClientOption represents a configuration option for the client
 type ClientOption func(*BaseClient)
 
@@ -41,17 +91,39 @@ func WithUserID(userID string) ClientOption {
 	}
 }
 
+// WithTimeout sets the timeout applied to requests whose context has no deadline
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *BaseClient) {
+		c.Timeout = timeout
+	}
+}
+
+// WithRetries sets how many times a request is retried after a 502, 503 or 504
+// response or a connection error, and the delay before the first retry. The
+// delay doubles on each further retry. Zero retries disables retrying.
+func WithRetries(maxRetries int, backoff time.Duration) ClientOption {
+	return func(c *BaseClient) {
+		c.MaxRetries = maxRetries
+		c.RetryBackoff = backoff
+	}
+}
+
 // BaseClient contains the shared HTTP functionality used by all sub-clients
 type BaseClient struct {
-	BaseURL    string
-	HTTPClient *http.Client
-	UserID     string // Default user ID for requests that require it
+	BaseURL      string
+	HTTPClient   *http.Client
+	UserID       string        // Default user ID for requests that require it
+	Timeout      time.Duration // Default timeout for requests without a context deadline
+	MaxRetries   int           // Retries after transient failures, see WithRetries
+	RetryBackoff time.Duration // Delay before the first retry
 }
 
 // NewBaseClient creates a new base client with the given configuration
 func NewBaseClient(baseURL string, options ...ClientOption) *BaseClient {
 	client := &BaseClient{
-		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		BaseURL:      strings.TrimSuffix(baseURL, "/"),
+		MaxRetries:   2,
+		RetryBackoff: 200 * time.Millisecond,
 	}
 
 	for _, option := range options {
@@ -59,7 +131,12 @@ func NewBaseClient(baseURL string, options ...ClientOption) *BaseClient {
 	}
 
 	if client.HTTPClient == nil {
-		client.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+		if client.Timeout > 0 {
+			// Requests are bounded by the context timeout instead
+			client.HTTPClient = &http.Client{}
+		} else {
+			client.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+		}
 	}
 
 	return client
@@ -84,13 +161,47 @@ func (c *BaseClient) addUserID(req *http.Request, userID string) {
 }
 
 func (c *BaseClient) doRequest(ctx context.Context, method, path string, body any, userID string) (*http.Response, error) {
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok && c.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+	}
+
+	backoff := c.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := c.sendRequest(ctx, method, path, jsonBody, userID)
+		if err == nil {
+			// The body is read after doRequest returns, so the timeout is released on Close
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+		if attempt >= c.MaxRetries || !isRetryable(method, err) || ctx.Err() != nil {
+			cancel()
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			cancel()
+			return nil, &TransportError{Err: ctx.Err()}
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (c *BaseClient) sendRequest(ctx context.Context, method, path string, jsonBody []byte, userID string) (*http.Response, error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
 	}
 
 	urlStr := c.buildURL(path)
@@ -102,13 +213,13 @@ func (c *BaseClient) doRequest(ctx context.Context, method, path string, body an
 		c.addUserID(req, userID)
 	}
 
-	if body != nil {
+	if jsonBody != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, &TransportError{Err: err}
 	}
 
 	if resp.StatusCode >= 400 {
@@ -134,6 +245,54 @@ func (c *BaseClient) doRequest(ctx context.Context, method, path string, body an
 	return resp, nil
 }
 
+// isRetryable reports whether a failed request may be sent again. Idempotent
+// requests are retried on gateway errors and failures to reach the server.
+// Other requests, such as POST, are only retried when the connection could
+// not be established, since the server may already have acted on them.
+// Timeouts are never retried: the server may simply be slow, and retrying
+// would multiply the time the caller waits.
+func isRetryable(method string, err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return false
+	}
+
+	idempotent := false
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		idempotent = true
+	}
+
+	var clientErr *ClientError
+	if errors.As(err, &clientErr) {
+		switch clientErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return idempotent
+		}
+		return false
+	}
+	if !IsTransportError(err) {
+		return false
+	}
+	if idempotent {
+		return true
+	}
+	// Connection refused and DNS failures happen while dialing, before
+	// anything has been sent
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 func (c *BaseClient) Get(ctx context.Context, path string, userID string) (*http.Response, error) {
 	return c.doRequest(ctx, http.MethodGet, path, nil, userID)
 }
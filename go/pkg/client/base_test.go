@@ -0,0 +1,296 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestDoRequestTypedErrors(t *testing.T) {
+	tests := []struct {
+		status       int
+		notFound     bool
+		unauthorized bool
+	}{
+		{http.StatusNotFound, true, false},
+		{http.StatusUnauthorized, false, true},
+		{http.StatusForbidden, false, true},
+		{http.StatusBadRequest, false, false},
+		{http.StatusInternalServerError, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"error":"something went wrong"}`))
+			}))
+			defer server.Close()
+
+			c := NewBaseClient(server.URL)
+			_, err := c.Get(context.Background(), "/api/modelconfigs/default/missing", "")
+			if err == nil {
+				t.Fatalf("Expected error, but got nil")
+			}
+
+			var clientErr *ClientError
+			if !errors.As(err, &clientErr) {
+				t.Fatalf("Expected *ClientError, but got %T", err)
+			}
+			if clientErr.StatusCode != tt.status {
+				t.Fatalf("Expected status %d, but got %d", tt.status, clientErr.StatusCode)
+			}
+			if clientErr.Message != "something went wrong" {
+				t.Fatalf("Expected message from response body, but got %q", clientErr.Message)
+			}
+			if IsNotFound(err) != tt.notFound {
+				t.Fatalf("Expected IsNotFound to be %v", tt.notFound)
+			}
+			if IsUnauthorized(err) != tt.unauthorized {
+				t.Fatalf("Expected IsUnauthorized to be %v", tt.unauthorized)
+			}
+			if IsTransportError(err) {
+				t.Fatalf("Expected IsTransportError to be false for an HTTP response")
+			}
+		})
+	}
+}
+
+func TestDoRequestTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	c := NewBaseClient(url)
+	_, err := c.Get(context.Background(), "/api/modelconfigs", "")
+	if err == nil {
+		t.Fatalf("Expected error, but got nil")
+	}
+	if !IsTransportError(err) {
+		t.Fatalf("Expected a transport error, but got %T: %v", err, err)
+	}
+	if IsNotFound(err) || IsUnauthorized(err) {
+		t.Fatalf("Expected transport error not to match HTTP status errors")
+	}
+}
+
+func TestDefaultTimeoutAppliesWithoutDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := NewBaseClient(server.URL, WithTimeout(50*time.Millisecond))
+	start := time.Now()
+	_, err := c.Get(context.Background(), "/api/agents", "")
+	if err == nil {
+		t.Fatalf("Expected error, but got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded, but got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Expected request to time out quickly, but took %s", elapsed)
+	}
+}
+
+func TestDefaultTimeoutKeepsCallerDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"data":"ok"}`))
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithTimeout(10*time.Millisecond))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.Get(ctx, "/api/agents", "")
+	if err != nil {
+		t.Fatalf("Expected the caller's deadline to take precedence, but got %v", err)
+	}
+	var body map[string]string
+	if err := DecodeResponse(resp, &body); err != nil {
+		t.Fatalf("Expected no error decoding response, but got %v", err)
+	}
+	if body["data"] != "ok" {
+		t.Fatalf("Expected data ok, but got %q", body["data"])
+	}
+}
+
+func TestDefaultTimeoutCoversBodyRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":"ok"}`))
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithTimeout(time.Second))
+	resp, err := c.Get(context.Background(), "/api/agents", "")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	var body map[string]string
+	if err := DecodeResponse(resp, &body); err != nil {
+		t.Fatalf("Expected the body to be readable after doRequest returns, but got %v", err)
+	}
+}
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":"ok"}`))
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithRetries(2, time.Millisecond))
+	resp, err := c.Get(context.Background(), "/api/agents", "")
+	if err != nil {
+		t.Fatalf("Expected the request to succeed after a retry, but got %v", err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 2 {
+		t.Fatalf("Expected 2 calls, but got %d", calls.Load())
+	}
+}
+
+func TestNoRetryOnPostAfterServiceUnavailable(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithRetries(2, time.Millisecond))
+	if _, err := c.Post(context.Background(), "/api/agents", map[string]string{"name": "test"}, ""); err == nil {
+		t.Fatalf("Expected error, but got nil")
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, but got %d", calls.Load())
+	}
+}
+
+func TestRetryPostOnDialError(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"data":"ok"}`))
+	}))
+	defer server.Close()
+
+	var dials atomic.Int32
+	dialer := &net.Dialer{}
+	c := NewBaseClient(server.URL, WithRetries(2, time.Millisecond))
+	c.HTTPClient = &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			if dials.Add(1) == 1 {
+				return nil, &net.OpError{Op: "dial", Net: network, Err: syscall.ECONNREFUSED}
+			}
+			return dialer.DialContext(ctx, network, addr)
+		},
+	}}
+
+	resp, err := c.Post(context.Background(), "/api/agents", map[string]string{"name": "test"}, "")
+	if err != nil {
+		t.Fatalf("Expected the request to succeed after a retry, but got %v", err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 1 {
+		t.Fatalf("Expected the server to see 1 call, but got %d", calls.Load())
+	}
+}
+
+func TestNoRetryOnClientTimeout(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithRetries(2, time.Millisecond))
+	c.HTTPClient = &http.Client{Timeout: 20 * time.Millisecond}
+
+	_, err := c.Get(context.Background(), "/api/agents", "")
+	if !IsTransportError(err) {
+		t.Fatalf("Expected a transport error, but got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, but got %d", calls.Load())
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithRetries(2, time.Millisecond))
+	_, err := c.Get(context.Background(), "/api/agents", "")
+	var clientErr *ClientError
+	if !errors.As(err, &clientErr) || clientErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("Expected a 502 ClientError, but got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("Expected 3 calls, but got %d", calls.Load())
+	}
+}
+
+func TestNoRetryOnClientError(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithRetries(2, time.Millisecond))
+	if _, err := c.Get(context.Background(), "/api/agents", ""); err == nil {
+		t.Fatalf("Expected error, but got nil")
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, but got %d", calls.Load())
+	}
+}
+
+func TestRetryHonorsContextCancellation(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewBaseClient(server.URL, WithRetries(5, time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Get(ctx, "/api/agents", "")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded while waiting to retry, but got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, but got %d", calls.Load())
+	}
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/kagent-dev/kagent/go/pkg/client/api"
+)
+
+func TestListSupportedModelProvidersDecodesAllFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/providers/models" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"name": "Bedrock",
+				"type": "Bedrock",
+				"requiredParams": ["region"],
+				"optionalParams": ["headers"],
+				"capabilities": ["tool-calling", "embeddings", "vision"],
+				"requiresSecret": false,
+				"authStyle": "aws",
+				"knownModels": ["anthropic.claude-3-sonnet-20240229-v1:0"]
+			}, {
+				"name": "Gemini",
+				"type": "Gemini",
+				"requiredParams": [],
+				"optionalParams": [],
+				"capabilities": ["tool-calling"],
+				"requiresSecret": true,
+				"authStyle": "api-key"
+			}],
+			"message": "Successfully listed supported model providers",
+			"error": false
+		}`))
+	}))
+	defer server.Close()
+
+	c := NewProviderClient(NewBaseClient(server.URL))
+	resp, err := c.ListSupportedModelProviders(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := []api.ProviderInfo{
+		{
+			Name:           "Bedrock",
+			Type:           "Bedrock",
+			RequiredParams: []string{"region"},
+			OptionalParams: []string{"headers"},
+			Capabilities:   []string{"tool-calling", "embeddings", "vision"},
+			AuthStyle:      "aws",
+			KnownModels:    []string{"anthropic.claude-3-sonnet-20240229-v1:0"},
+		},
+		{
+			Name:           "Gemini",
+			Type:           "Gemini",
+			RequiredParams: []string{},
+			OptionalParams: []string{},
+			Capabilities:   []string{"tool-calling"},
+			RequiresSecret: true,
+			AuthStyle:      "api-key",
+		},
+	}
+	if !reflect.DeepEqual(resp.Data, expected) {
+		t.Fatalf("Expected %+v, but got %+v", expected, resp.Data)
+	}
+}
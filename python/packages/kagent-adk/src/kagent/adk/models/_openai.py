@@ -4,7 +4,7 @@ import base64
 import json
 import os
 from functools import cached_property
-from typing import TYPE_CHECKING, Any, AsyncGenerator, Iterable, Literal, Optional
+from typing import TYPE_CHECKING, Any, AsyncGenerator, Iterable, Literal, Optional, Union
 
 import httpx
 from google.adk.models import BaseLlm
@@ -301,6 +301,9 @@ class BaseOpenAI(BaseLlm):
     temperature: Optional[float] = None
     timeout: Optional[int] = None
     top_p: Optional[float] = None
+    response_format: Optional[dict[str, Any]] = None
+    tool_choice: Optional[Union[str, dict[str, Any]]] = None
+    parallel_tool_calls: Optional[bool] = None
 
     # TLS/SSL configuration fields
     tls_disable_verify: Optional[bool] = None
@@ -410,6 +413,8 @@ class BaseOpenAI(BaseLlm):
             kwargs["temperature"] = self.temperature
         if self.top_p is not None:
             kwargs["top_p"] = self.top_p
+        if self.response_format is not None:
+            kwargs["response_format"] = self.response_format
 
         # Handle tools
         if llm_request.config and llm_request.config.tools:
@@ -423,7 +428,10 @@ class BaseOpenAI(BaseLlm):
                 openai_tools = _convert_tools_to_openai(genai_tools)
                 if openai_tools:
                     kwargs["tools"] = openai_tools
-                    kwargs["tool_choice"] = "auto"
+                    kwargs["tool_choice"] = self.tool_choice or "auto"
+                    # OpenAI rejects parallel_tool_calls on requests without tools
+                    if self.parallel_tool_calls is not None:
+                        kwargs["parallel_tool_calls"] = self.parallel_tool_calls
 
         try:
             if stream:
@@ -564,5 +572,6 @@ class AzureOpenAI(BaseOpenAI):
             api_version=api_version,
             azure_endpoint=azure_endpoint,
             default_headers=self.default_headers,
+            timeout=self.timeout,
             http_client=http_client,
         )
@@ -178,16 +178,30 @@ class OpenAI(BaseLLM):
     temperature: float | None = None
     timeout: int | None = None
     top_p: float | None = None
+    response_format: dict[str, Any] | None = None
+    # "auto", "none", "required" or {"type": "function", "function": {"name": ...}}
+    tool_choice: str | dict[str, Any] | None = None
+    parallel_tool_calls: bool | None = None
 
     type: Literal["openai"]
 
 
 class AzureOpenAI(BaseLLM):
+    timeout: int | None = None
+
     type: Literal["azure_openai"]
 
 
 class Anthropic(BaseLLM):
     base_url: str | None = None
+    max_tokens: int | None = None
+    temperature: float | None = None
+    top_p: float | None = None
+    top_k: int | None = None
+    timeout: int | None = None
+    # OpenAI-style tool settings; LiteLLM converts them to Anthropic's tool_choice
+    tool_choice: str | dict[str, Any] | None = None
+    parallel_tool_calls: bool | None = None
 
     type: Literal["anthropic"]
 
@@ -197,11 +211,14 @@ class GeminiVertexAI(BaseLLM):
 
 
 class GeminiAnthropic(BaseLLM):
+    max_tokens: int | None = None
+
     type: Literal["gemini_anthropic"]
 
 
 class Ollama(BaseLLM):
     options: dict[str, str] | None = None
+    timeout: int | None = None
     type: Literal["ollama"]
 
 
@@ -211,6 +228,7 @@ class Gemini(BaseLLM):
 
 class Bedrock(BaseLLM):
     region: str | None = None
+    timeout: int | None = None
     type: Literal["bedrock"]
 
 
@@ -352,6 +370,9 @@ class AgentConfig(BaseModel):
                 temperature=self.model.temperature,
                 timeout=self.model.timeout,
                 top_p=self.model.top_p,
+                response_format=self.model.response_format,
+                tool_choice=self.model.tool_choice,
+                parallel_tool_calls=self.model.parallel_tool_calls,
                 # TLS configuration
                 tls_disable_verify=self.model.tls_disable_verify,
                 tls_ca_cert_path=self.model.tls_ca_cert_path,
@@ -359,21 +380,38 @@ class AgentConfig(BaseModel):
             )
         elif self.model.type == "anthropic":
             model = LiteLlm(
-                model=f"anthropic/{self.model.model}", base_url=self.model.base_url, extra_headers=extra_headers
+                model=f"anthropic/{self.model.model}",
+                base_url=self.model.base_url,
+                extra_headers=extra_headers,
+                max_tokens=self.model.max_tokens,
+                temperature=self.model.temperature,
+                top_p=self.model.top_p,
+                top_k=self.model.top_k,
+                timeout=self.model.timeout,
+                tool_choice=self.model.tool_choice,
+                parallel_tool_calls=self.model.parallel_tool_calls,
             )
         elif self.model.type == "gemini_vertex_ai":
             model = GeminiLLM(model=self.model.model)
         elif self.model.type == "gemini_anthropic":
-            model = ClaudeLLM(model=self.model.model)
+            # Claude has a non-optional max_tokens default, so only override it when set
+            claude_args = {"max_tokens": self.model.max_tokens} if self.model.max_tokens else {}
+            model = ClaudeLLM(model=self.model.model, **claude_args)
         elif self.model.type == "ollama":
             # Convert string options to correct types (int, float, bool) for Ollama API
             ollama_options = _convert_ollama_options(self.model.options)
-            model = LiteLlm(model=f"ollama_chat/{self.model.model}", extra_headers=extra_headers, **ollama_options)
+            model = LiteLlm(
+                model=f"ollama_chat/{self.model.model}",
+                extra_headers=extra_headers,
+                timeout=self.model.timeout,
+                **ollama_options,
+            )
         elif self.model.type == "azure_openai":
             model = OpenAIAzure(
                 model=self.model.model,
                 type="azure_openai",
                 default_headers=extra_headers,
+                timeout=self.model.timeout,
                 # TLS configuration
                 tls_disable_verify=self.model.tls_disable_verify,
                 tls_ca_cert_path=self.model.tls_ca_cert_path,
@@ -383,7 +421,11 @@ class AgentConfig(BaseModel):
             model = self.model.model
         elif self.model.type == "bedrock":
             # LiteLLM handles Bedrock via boto3 internally when model starts with "bedrock/"
-            model = LiteLlm(model=f"bedrock/{self.model.model}", extra_headers=extra_headers)
+            model = LiteLlm(
+                model=f"bedrock/{self.model.model}",
+                extra_headers=extra_headers,
+                timeout=self.model.timeout,
+            )
         else:
             raise ValueError(f"Invalid model type: {self.model.type}")
         return Agent(
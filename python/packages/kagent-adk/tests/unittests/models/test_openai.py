@@ -385,6 +385,60 @@ async def test_generate_content_async_with_max_tokens(llm_request, generate_cont
         assert kwargs["max_tokens"] == 4096
 
 
+@pytest.mark.asyncio
+async def test_generate_content_async_with_tool_settings(generate_content_response):
+    openai_llm = OpenAI(
+        model="gpt-4o",
+        type="openai",
+        api_key="fake",
+        response_format={"type": "json_object"},
+        tool_choice={"type": "function", "function": {"name": "get_weather"}},
+        parallel_tool_calls=False,
+    )
+    llm_request = LlmRequest(
+        model="gpt-4o",
+        contents=[Content(role="user", parts=[Part.from_text(text="Weather in Paris?")])],
+        config=types.GenerateContentConfig(
+            tools=[
+                types.Tool(
+                    function_declarations=[types.FunctionDeclaration(name="get_weather", description="Get the weather")]
+                )
+            ],
+        ),
+    )
+    with mock.patch.object(openai_llm, "_client") as mock_client:
+
+        async def mock_coro(*args, **kwargs):
+            return generate_content_response
+
+        mock_client.chat.completions.create.return_value = mock_coro()
+
+        _ = [resp async for resp in openai_llm.generate_content_async(llm_request, stream=False)]
+        _, kwargs = mock_client.chat.completions.create.call_args
+        assert kwargs["response_format"] == {"type": "json_object"}
+        assert kwargs["tool_choice"] == {"type": "function", "function": {"name": "get_weather"}}
+        assert kwargs["parallel_tool_calls"] is False
+
+
+@pytest.mark.asyncio
+async def test_generate_content_async_without_tools_omits_tool_settings(llm_request, generate_content_response):
+    openai_llm = OpenAI(
+        model="gpt-3.5-turbo", type="openai", api_key="fake", tool_choice="required", parallel_tool_calls=False
+    )
+    with mock.patch.object(openai_llm, "_client") as mock_client:
+
+        async def mock_coro(*args, **kwargs):
+            return generate_content_response
+
+        mock_client.chat.completions.create.return_value = mock_coro()
+
+        _ = [resp async for resp in openai_llm.generate_content_async(llm_request, stream=False)]
+        _, kwargs = mock_client.chat.completions.create.call_args
+        assert "tool_choice" not in kwargs
+        assert "parallel_tool_calls" not in kwargs
+        assert "response_format" not in kwargs
+
+
 @pytest.mark.asyncio
 async def test_streaming_vs_non_streaming_equivalence(
     openai_llm, llm_request, generate_content_response, generate_streaming_content_response
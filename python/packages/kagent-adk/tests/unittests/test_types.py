@@ -0,0 +1,114 @@
+from unittest import mock
+
+import pytest
+from google.adk.models.anthropic_llm import Claude as ClaudeLLM
+from google.adk.models.lite_llm import LiteLlm
+
+from kagent.adk.models import AzureOpenAI as OpenAIAzure
+from kagent.adk.models import OpenAI as OpenAINative
+from kagent.adk.types import AgentConfig, Anthropic, AzureOpenAI, Bedrock, GeminiAnthropic, Ollama, OpenAI
+
+
+def _to_agent(model):
+    config = AgentConfig(model=model, description="Test agent", instruction="You are a test agent")
+    return config.to_agent("test_agent")
+
+
+def test_azure_openai_timeout_reaches_client(monkeypatch):
+    """The configured timeout is passed to the Azure OpenAI SDK client."""
+    monkeypatch.setenv("AZURE_OPENAI_ENDPOINT", "https://test.openai.azure.com")
+    monkeypatch.setenv("AZURE_OPENAI_API_KEY", "fake")
+
+    agent = _to_agent(AzureOpenAI(type="azure_openai", model="gpt-4o", timeout=42))
+
+    assert isinstance(agent.model, OpenAIAzure)
+    assert agent.model.timeout == 42
+    with mock.patch("kagent.adk.models._openai.AsyncAzureOpenAI") as mock_azure_openai:
+        _ = agent.model._client
+    assert mock_azure_openai.call_args[1]["timeout"] == 42
+
+
+@pytest.mark.parametrize(
+    "model",
+    [
+        Anthropic(type="anthropic", model="claude-sonnet-4-5", timeout=42),
+        Ollama(type="ollama", model="llama3", timeout=42),
+        Bedrock(type="bedrock", model="anthropic.claude-3-sonnet-20240229-v1:0", timeout=42),
+    ],
+    ids=lambda model: model.type,
+)
+def test_litellm_timeout_reaches_completion_args(model):
+    """The configured timeout is forwarded to every LiteLLM completion call."""
+    agent = _to_agent(model)
+
+    assert isinstance(agent.model, LiteLlm)
+    assert agent.model._additional_args["timeout"] == 42
+
+
+@pytest.mark.parametrize(
+    "model",
+    [
+        Anthropic(type="anthropic", model="claude-sonnet-4-5"),
+        Ollama(type="ollama", model="llama3"),
+        Bedrock(type="bedrock", model="anthropic.claude-3-sonnet-20240229-v1:0"),
+    ],
+    ids=lambda model: model.type,
+)
+def test_litellm_timeout_unset(model):
+    """Without a configured timeout LiteLLM falls back to its own default."""
+    agent = _to_agent(model)
+
+    assert agent.model._additional_args.get("timeout") is None
+
+
+def test_openai_tool_settings_reach_model():
+    """response_format, tool_choice and parallel_tool_calls are passed to the OpenAI model."""
+    agent = _to_agent(
+        OpenAI(
+            type="openai",
+            model="gpt-4o",
+            response_format={"type": "json_object"},
+            tool_choice="required",
+            parallel_tool_calls=False,
+        )
+    )
+
+    assert isinstance(agent.model, OpenAINative)
+    assert agent.model.response_format == {"type": "json_object"}
+    assert agent.model.tool_choice == "required"
+    assert agent.model.parallel_tool_calls is False
+
+
+def test_anthropic_tool_settings_reach_completion_args():
+    """Anthropic tool settings are forwarded to LiteLLM in the OpenAI shape it expects."""
+    tool_choice = {"type": "function", "function": {"name": "get_weather"}}
+    agent = _to_agent(
+        Anthropic(type="anthropic", model="claude-sonnet-4-5", tool_choice=tool_choice, parallel_tool_calls=False)
+    )
+
+    assert agent.model._additional_args["tool_choice"] == tool_choice
+    assert agent.model._additional_args["parallel_tool_calls"] is False
+
+
+def test_anthropic_sampling_settings_reach_completion_args():
+    """Anthropic sampling settings from the ModelConfig are forwarded to LiteLLM."""
+    agent = _to_agent(
+        Anthropic(type="anthropic", model="claude-sonnet-4-5", max_tokens=2048, temperature=0.2, top_p=0.9, top_k=40)
+    )
+
+    args = agent.model._additional_args
+    assert args["max_tokens"] == 2048
+    assert args["temperature"] == 0.2
+    assert args["top_p"] == 0.9
+    assert args["top_k"] == 40
+
+
+def test_gemini_anthropic_max_tokens():
+    """max_tokens overrides Claude's default only when set."""
+    agent = _to_agent(GeminiAnthropic(type="gemini_anthropic", model="claude-sonnet-4@20250514", max_tokens=2048))
+    assert isinstance(agent.model, ClaudeLLM)
+    assert agent.model.max_tokens == 2048
+
+    default = ClaudeLLM(model="claude-sonnet-4@20250514").max_tokens
+    agent = _to_agent(GeminiAnthropic(type="gemini_anthropic", model="claude-sonnet-4@20250514"))
+    assert agent.model.max_tokens == default